@@ -0,0 +1,294 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// crontabMacros maps the supported @-shorthands to their 5-field equivalent.
+var crontabMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var crontabMonthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var crontabDayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+// crontabField describes the bounds and accepted names of a single cron field.
+type crontabField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var crontabFields = []crontabField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: crontabMonthNames},
+	// 7 is accepted as an alias for Sunday and folded onto 0 after parsing.
+	{name: "day of week", min: 0, max: 7, names: crontabDayNames},
+}
+
+// crontabSchedule is a parsed 5-field cron expression. Each field is a bitset
+// of the values it matches.
+type crontabSchedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+	// Standard cron semantics: when both day fields are restricted, a day
+	// matches if either of them matches.
+	dayOfMonthAny bool
+	dayOfWeekAny  bool
+}
+
+// parseCrontab parses a standard 5-field cron expression or one of the
+// supported @-macros. Any API annotation is stripped first.
+func parseCrontab(expr string) (*crontabSchedule, error) {
+	expr = normalizeCrontab(expr)
+	if strings.HasPrefix(expr, "@") {
+		expanded, ok := crontabMacros[strings.ToLower(expr)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported macro %q", expr)
+		}
+		expr = expanded
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) != len(crontabFields) {
+		return nil, fmt.Errorf("expected 5 fields (minute hour day_of_month month day_of_week), got %d", len(parts))
+	}
+
+	bits := make([]uint64, len(parts))
+	for i, part := range parts {
+		b, err := parseCrontabField(part, crontabFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s field %q: %s", crontabFields[i].name, part, err)
+		}
+		bits[i] = b
+	}
+
+	// Fold Sunday-as-7 onto 0.
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = (bits[4] &^ (1 << 7)) | 1
+	}
+
+	s := &crontabSchedule{
+		minute:        bits[0],
+		hour:          bits[1],
+		dayOfMonth:    bits[2],
+		month:         bits[3],
+		dayOfWeek:     bits[4],
+		dayOfMonthAny: parts[2] == "*" || parts[2] == "?",
+		dayOfWeekAny:  parts[4] == "*" || parts[4] == "?",
+	}
+
+	if s.dayOfWeekAny && !s.canMatchDayOfMonth() {
+		return nil, fmt.Errorf("day of month and month never coincide, the schedule would never run")
+	}
+
+	return s, nil
+}
+
+// parseCrontabField parses a comma-separated list of values, ranges and steps
+// into a bitset.
+func parseCrontabField(field string, f crontabField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		if item == "" {
+			return 0, fmt.Errorf("empty list element")
+		}
+
+		rangePart, step := item, 1
+		if idx := strings.Index(item, "/"); idx != -1 {
+			rangePart = item[:idx]
+			n, err := strconv.Atoi(item[idx+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("step %q must be a positive integer", item[idx+1:])
+			}
+			step = n
+		}
+
+		var lo, hi int
+		switch {
+		case rangePart == "*" || rangePart == "?":
+			lo, hi = f.min, f.max
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if lo, err = parseCrontabValue(bounds[0], f); err != nil {
+				return 0, err
+			}
+			if hi, err = parseCrontabValue(bounds[1], f); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("range start %d is greater than range end %d", lo, hi)
+			}
+		default:
+			v, err := parseCrontabValue(rangePart, f)
+			if err != nil {
+				return 0, err
+			}
+			// "5/15" means "starting at 5, every 15".
+			lo, hi = v, v
+			if step > 1 {
+				hi = f.max
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func parseCrontabValue(s string, f crontabField) (int, error) {
+	if f.names != nil {
+		if v, ok := f.names[strings.ToUpper(s)]; ok {
+			return v, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// canMatchDayOfMonth reports whether at least one selected day of month exists
+// in one of the selected months (e.g. "0 0 30 2 *" never runs).
+func (s *crontabSchedule) canMatchDayOfMonth() bool {
+	daysInMonth := []int{31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	for m := 1; m <= 12; m++ {
+		if s.month&(1<<uint(m)) == 0 {
+			continue
+		}
+		for d := 1; d <= daysInMonth[m-1]; d++ {
+			if s.dayOfMonth&(1<<uint(d)) != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *crontabSchedule) matchesDay(t time.Time) bool {
+	domMatch := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dowMatch := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.dayOfMonthAny || s.dayOfWeekAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// next returns the first time strictly after the given time at which the
+// schedule fires, evaluated in the location of after. The search is bounded
+// so that a schedule that cannot fire does not loop forever.
+//
+// Minutes and hours are stepped in absolute time so that daylight saving
+// transitions cannot stall the search: wall-clock times skipped when clocks
+// spring forward never fire, and times repeated when clocks fall back fire
+// once per occurrence.
+func (s *crontabSchedule) next(after time.Time) (time.Time, bool) {
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = advanceCrontab(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = advanceCrontab(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc))
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// advanceCrontab returns candidate when it lies after t. time.Date resolves a
+// midnight that does not exist (zones switching to DST at 00:00) to the hour
+// before, which can be at or before t; stepping an hour instead keeps the
+// search moving forward.
+func advanceCrontab(t, candidate time.Time) time.Time {
+	if candidate.After(t) {
+		return candidate
+	}
+	return t.Add(time.Hour)
+}
+
+// nextN returns up to n upcoming fire times after the given time.
+func (s *crontabSchedule) nextN(after time.Time, n int) []time.Time {
+	times := make([]time.Time, 0, n)
+	for len(times) < n {
+		t, ok := s.next(after)
+		if !ok {
+			break
+		}
+		times = append(times, t)
+		after = t
+	}
+	return times
+}
+
+// crontabValidator validates that a string attribute holds a cron expression
+// accepted by parseCrontab.
+type crontabValidator struct{}
+
+var _ validator.String = crontabValidator{}
+
+func (v crontabValidator) Description(_ context.Context) string {
+	return "value must be a valid 5-field cron expression or one of @yearly, @annually, @monthly, @weekly, @daily, @midnight, @hourly"
+}
+
+func (v crontabValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v crontabValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseCrontab(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid crontab expression",
+			fmt.Sprintf("%q is not a valid cron expression: %s.", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
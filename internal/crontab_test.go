@@ -0,0 +1,178 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCrontab_Valid(t *testing.T) {
+	cases := []string{
+		"0 12 * * *",
+		"*/5 * * * *",
+		"0 9 * * 1 (m/h/dM/MY/d) UTC",
+		"0 9-17/2 * * MON-FRI",
+		"15,45 0 1 JAN,jul *",
+		"0 0 * * 7",
+		"5/15 * * * *",
+		"@daily",
+		"@HOURLY",
+		"0 0 29 2 *",
+	}
+	for _, expr := range cases {
+		_, err := parseCrontab(expr)
+		assert.NoError(t, err, expr)
+	}
+}
+
+func TestParseCrontab_Invalid(t *testing.T) {
+	cases := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"1,,2 * * * *",
+		"* * * FOO *",
+		"@reboot",
+		"0 0 30 2 *",
+	}
+	for _, expr := range cases {
+		_, err := parseCrontab(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestCrontabSchedule_NextN(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	cases := map[string][]time.Time{
+		"0 12 * * *": {
+			time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+			time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC),
+		},
+		"*/20 * * * *": {
+			time.Date(2025, 1, 1, 10, 40, 0, 0, time.UTC),
+			time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC),
+		},
+		// 2025-01-01 is a Wednesday.
+		"0 9 * * MON": {
+			time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+			time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC),
+		},
+		// Sunday written as 7.
+		"0 0 * * 7": {
+			time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC),
+		},
+		// Both day fields restricted: either one matches.
+		"0 0 15 * FRI": {
+			time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+		},
+		"0 0 29 2 *": {
+			time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+			time.Date(2032, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		"@monthly": {
+			time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for expr, want := range cases {
+		s, err := parseCrontab(expr)
+		require.NoError(t, err, expr)
+		assert.Equal(t, want, s.nextN(start, len(want)), expr)
+	}
+}
+
+func TestCrontabSchedule_NextN_DaylightSaving(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	cases := []struct {
+		name  string
+		expr  string
+		start time.Time
+		want  []string
+	}{
+		{
+			name:  "daily run across spring forward",
+			expr:  "0 9 * * *",
+			start: time.Date(2026, 3, 6, 12, 0, 0, 0, loc),
+			want:  []string{"2026-03-07T09:00:00-05:00", "2026-03-08T09:00:00-04:00", "2026-03-09T09:00:00-04:00"},
+		},
+		{
+			name:  "run before the skipped hour",
+			expr:  "30 1 * * *",
+			start: time.Date(2025, 3, 8, 12, 0, 0, 0, loc),
+			want:  []string{"2025-03-09T01:30:00-05:00", "2025-03-10T01:30:00-04:00"},
+		},
+		{
+			name:  "skipped wall-clock times do not fire",
+			expr:  "*/20 1-2 * * *",
+			start: time.Date(2025, 3, 9, 0, 0, 0, 0, loc),
+			want: []string{
+				"2025-03-09T01:00:00-05:00", "2025-03-09T01:20:00-05:00", "2025-03-09T01:40:00-05:00",
+				"2025-03-10T01:00:00-04:00",
+			},
+		},
+		{
+			name:  "repeated hour fires once per occurrence on fall back",
+			expr:  "30 1 * * *",
+			start: time.Date(2025, 11, 1, 12, 0, 0, 0, loc),
+			want:  []string{"2025-11-02T01:30:00-04:00", "2025-11-02T01:30:00-05:00", "2025-11-03T01:30:00-05:00"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := parseCrontab(tc.expr)
+			require.NoError(t, err)
+			got := []string{}
+			for _, run := range s.nextN(tc.start, len(tc.want)) {
+				got = append(got, run.Format(time.RFC3339))
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCrontabValidator(t *testing.T) {
+	ctx := context.Background()
+
+	for value, wantErr := range map[string]bool{
+		"0 12 * * *": false,
+		"@weekly":    false,
+		"0 25 * * *": true,
+	} {
+		req := validator.StringRequest{
+			Path:        path.Root("crontab"),
+			ConfigValue: types.StringValue(value),
+		}
+		resp := &validator.StringResponse{}
+		crontabValidator{}.ValidateString(ctx, req, resp)
+		assert.Equal(t, wantErr, resp.Diagnostics.HasError(), value)
+	}
+
+	// Unknown values are deferred until apply.
+	resp := &validator.StringResponse{}
+	crontabValidator{}.ValidateString(ctx, validator.StringRequest{
+		Path:        path.Root("crontab"),
+		ConfigValue: types.StringUnknown(),
+	}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+}
@@ -70,7 +70,7 @@ func (d *IntegrationDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Computed:    true,
 			},
 			"type": schema.StringAttribute{
-				Description: "The type of integration (e.g., WEBHOOK, CUSTOM, SLACK).",
+				Description: "The type of integration (e.g., WEBHOOK, CUSTOM, SLACK, TEAMS, PAGERDUTY).",
 				Computed:    true,
 			},
 			"api_url": schema.StringAttribute{
@@ -23,13 +23,19 @@ type ModelProviderDataSource struct {
 }
 
 type ModelProviderDataSourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	OrganizationName types.String `tfsdk:"organization_name"`
-	Name             types.String `tfsdk:"name"`
-	Provider         types.String `tfsdk:"provider_type"`
-	Description      types.String `tfsdk:"description"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	UpdatedAt        types.String `tfsdk:"updated_at"`
+	ID                types.String `tfsdk:"id"`
+	OrganizationName  types.String `tfsdk:"organization_name"`
+	Name              types.String `tfsdk:"name"`
+	Provider          types.String `tfsdk:"provider_type"`
+	Description       types.String `tfsdk:"description"`
+	BaseURL           types.String `tfsdk:"base_url"`
+	APIVersion        types.String `tfsdk:"api_version"`
+	DeploymentName    types.String `tfsdk:"deployment_name"`
+	Model             types.String `tfsdk:"model"`
+	MaxTokens         types.Int64  `tfsdk:"max_tokens"`
+	RequestsPerMinute types.Int64  `tfsdk:"requests_per_minute"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
 }
 
 func (d *ModelProviderDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -63,6 +69,30 @@ func (d *ModelProviderDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				Description: "A description of the model provider.",
 				Computed:    true,
 			},
+			"base_url": schema.StringAttribute{
+				Description: "The endpoint the platform's agents send requests to; empty when the provider's public API is used.",
+				Computed:    true,
+			},
+			"api_version": schema.StringAttribute{
+				Description: "The Azure OpenAI API version (azure_openai only).",
+				Computed:    true,
+			},
+			"deployment_name": schema.StringAttribute{
+				Description: "The name of the Azure OpenAI deployment (azure_openai only).",
+				Computed:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "The model agents use unless a workspace selects another.",
+				Computed:    true,
+			},
+			"max_tokens": schema.Int64Attribute{
+				Description: "The maximum number of tokens agents may request per completion; 0 when unlimited.",
+				Computed:    true,
+			},
+			"requests_per_minute": schema.Int64Attribute{
+				Description: "The maximum number of requests per minute sent to the provider; 0 when unlimited.",
+				Computed:    true,
+			},
 			"created_at": schema.StringAttribute{
 				Description: "The timestamp when the model provider was created.",
 				Computed:    true,
@@ -164,6 +194,12 @@ func (d *ModelProviderDataSource) Read(ctx context.Context, req datasource.ReadR
 		data.Name = types.StringValue(mp.Name)
 		data.Provider = types.StringValue(mp.Provider)
 		data.Description = types.StringValue(mp.Description)
+		data.BaseURL = types.StringValue(mp.BaseURL)
+		data.APIVersion = types.StringValue(mp.APIVersion)
+		data.DeploymentName = types.StringValue(mp.DeploymentName)
+		data.Model = types.StringValue(mp.Model)
+		data.MaxTokens = types.Int64Value(mp.MaxTokens)
+		data.RequestsPerMinute = types.Int64Value(mp.RequestsPerMinute)
 		data.CreatedAt = types.StringValue(mp.CreatedAt)
 		data.UpdatedAt = types.StringValue(mp.UpdatedAt)
 	} else {
@@ -215,6 +251,12 @@ func (d *ModelProviderDataSource) Read(ctx context.Context, req datasource.ReadR
 				data.Name = types.StringValue(mp.Name)
 				data.Provider = types.StringValue(mp.Provider)
 				data.Description = types.StringValue(mp.Description)
+				data.BaseURL = types.StringValue(mp.BaseURL)
+				data.APIVersion = types.StringValue(mp.APIVersion)
+				data.DeploymentName = types.StringValue(mp.DeploymentName)
+				data.Model = types.StringValue(mp.Model)
+				data.MaxTokens = types.Int64Value(mp.MaxTokens)
+				data.RequestsPerMinute = types.Int64Value(mp.RequestsPerMinute)
 				data.CreatedAt = types.StringValue(mp.CreatedAt)
 				data.UpdatedAt = types.StringValue(mp.UpdatedAt)
 				found = true
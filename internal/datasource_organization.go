@@ -29,14 +29,15 @@ type OrganizationDataSource struct {
 
 // OrganizationDataSourceModel maps the data source schema data.
 type OrganizationDataSourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	CreatedAt     types.String `tfsdk:"created_at"`
-	UpdatedAt     types.String `tfsdk:"updated_at"`
-	ExecutionMode types.String `tfsdk:"execution_mode"`
-	AgentsEnabled types.Bool   `tfsdk:"agents_enabled"`
-	Members       types.List   `tfsdk:"members"`
-	Teams         types.List   `tfsdk:"teams"`
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	UpdatedAt              types.String `tfsdk:"updated_at"`
+	ExecutionMode          types.String `tfsdk:"execution_mode"`
+	AgentsEnabled          types.Bool   `tfsdk:"agents_enabled"`
+	DefaultModelProviderID types.String `tfsdk:"default_model_provider_id"`
+	Members                types.List   `tfsdk:"members"`
+	Teams                  types.List   `tfsdk:"teams"`
 }
 
 // OrganizationDataSourceFilterModel maps the filter parameters.
@@ -91,6 +92,10 @@ func (d *OrganizationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Description: "Whether agents are enabled for the organization.",
 				Computed:    true,
 			},
+			"default_model_provider_id": schema.StringAttribute{
+				Description: "ID of the model provider agents use in workspaces that do not select their own.",
+				Computed:    true,
+			},
 			"members": schema.ListNestedAttribute{
 				Description: "The members of the organization.",
 				Computed:    true,
@@ -248,6 +253,7 @@ func (d *OrganizationDataSource) mapOrganizationToModel(ctx context.Context, dat
 	data.UpdatedAt = types.StringValue(apiResp.UpdatedAt.Format(time.RFC3339))
 	data.ExecutionMode = types.StringValue(apiResp.ExecutionMode)
 	data.AgentsEnabled = types.BoolValue(apiResp.AgentsEnabled)
+	data.DefaultModelProviderID = optionalStringPointer(apiResp.DefaultModelProviderID)
 
 	// Map members
 	members := make([]OrganizationMemberModel, 0, len(apiResp.Members))
@@ -0,0 +1,247 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultTokenStaleAfterDays is used when stale_after_days is not set.
+const defaultTokenStaleAfterDays = 90
+
+var _ datasource.DataSource = &ServiceAccountTokensDataSource{}
+
+func NewServiceAccountTokensDataSource() datasource.DataSource {
+	return &ServiceAccountTokensDataSource{}
+}
+
+// ServiceAccountTokensDataSource reports the usage and expiry of service
+// account tokens so stale credentials can be found and rotated.
+type ServiceAccountTokensDataSource struct {
+	provider *InfradotsProvider
+}
+
+type ServiceAccountTokensDataSourceModel struct {
+	ServiceAccountID types.String `tfsdk:"service_account_id"`
+	StaleAfterDays   types.Int64  `tfsdk:"stale_after_days"`
+	IncludeExpired   types.Bool   `tfsdk:"include_expired"`
+	Tokens           types.List   `tfsdk:"tokens"`
+}
+
+type ServiceAccountTokensItemModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ServiceAccountID   types.String `tfsdk:"service_account_id"`
+	ServiceAccountName types.String `tfsdk:"service_account_name"`
+	Description        types.String `tfsdk:"description"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	LastUsed           types.String `tfsdk:"last_used"`
+	Expiration         types.String `tfsdk:"expiration"`
+	ExpiresInDays      types.Int64  `tfsdk:"expires_in_days"`
+	IsExpired          types.Bool   `tfsdk:"is_expired"`
+	IsStale            types.Bool   `tfsdk:"is_stale"`
+}
+
+var serviceAccountTokensItemAttrTypes = map[string]attr.Type{
+	"id":                   types.StringType,
+	"service_account_id":   types.StringType,
+	"service_account_name": types.StringType,
+	"description":          types.StringType,
+	"created_at":           types.StringType,
+	"last_used":            types.StringType,
+	"expiration":           types.StringType,
+	"expires_in_days":      types.Int64Type,
+	"is_expired":           types.BoolType,
+	"is_stale":             types.BoolType,
+}
+
+func (d *ServiceAccountTokensDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account_tokens_data"
+}
+
+func (d *ServiceAccountTokensDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the tokens of one or all service accounts with their expiry and usage (admin-only).",
+		Attributes: map[string]schema.Attribute{
+			"service_account_id": schema.StringAttribute{
+				Description: "Only list the tokens of this service account. If not set, the tokens of every service account are listed.",
+				Optional:    true,
+			},
+			"stale_after_days": schema.Int64Attribute{
+				Description: fmt.Sprintf("A token is stale when it has not been used for this many days. Defaults to %d.", defaultTokenStaleAfterDays),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"include_expired": schema.BoolAttribute{
+				Description: "Also list expired tokens. Defaults to false.",
+				Optional:    true,
+			},
+			"tokens": schema.ListNestedAttribute{
+				Description: "The tokens, grouped by service account.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The token unique ID (UUID).",
+							Computed:    true,
+						},
+						"service_account_id": schema.StringAttribute{
+							Description: "The ID of the service account the token belongs to.",
+							Computed:    true,
+						},
+						"service_account_name": schema.StringAttribute{
+							Description: "The name of the service account the token belongs to.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the token.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp when the token was created.",
+							Computed:    true,
+						},
+						"last_used": schema.StringAttribute{
+							Description: "The timestamp when the token was last used; empty if it was never used.",
+							Computed:    true,
+						},
+						"expiration": schema.StringAttribute{
+							Description: "The expiration date of the token; empty if it does not expire.",
+							Computed:    true,
+						},
+						"expires_in_days": schema.Int64Attribute{
+							Description: "Whole days until the token expires, rounded down so it is negative once the token has expired; null if it does not expire.",
+							Computed:    true,
+						},
+						"is_expired": schema.BoolAttribute{
+							Description: "Whether the token has expired.",
+							Computed:    true,
+						},
+						"is_stale": schema.BoolAttribute{
+							Description: "Whether the token has not been used for stale_after_days. Tokens that were never used are stale once they are older than stale_after_days.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ServiceAccountTokensDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*InfradotsProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *InfradotsProvider, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.provider = provider
+}
+
+func (d *ServiceAccountTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServiceAccountTokensDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staleAfterDays := int64(defaultTokenStaleAfterDays)
+	if !data.StaleAfterDays.IsNull() {
+		staleAfterDays = data.StaleAfterDays.ValueInt64()
+	}
+
+	accounts, err := listServiceAccounts(d.provider)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing service accounts", err.Error())
+		return
+	}
+	if id := data.ServiceAccountID.ValueString(); id != "" {
+		var selected []ServiceAccountAPIResponse
+		for _, sa := range accounts {
+			if sa.ID == id {
+				selected = append(selected, sa)
+			}
+		}
+		if len(selected) == 0 {
+			resp.Diagnostics.AddError("Service account not found", fmt.Sprintf("No service account with ID '%s'", id))
+			return
+		}
+		accounts = selected
+	}
+
+	now := time.Now()
+	items := []ServiceAccountTokensItemModel{}
+	for _, sa := range accounts {
+		tokens, err := listServiceAccountTokens(d.provider, sa.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error listing tokens of service account %s", sa.Name), err.Error())
+			return
+		}
+		for _, tok := range tokens {
+			expired := tokenExpired(tok, now)
+			if expired && !data.IncludeExpired.ValueBool() {
+				continue
+			}
+			expiresInDays := types.Int64Null()
+			if days, ok := tokenExpiresInDays(tok, now); ok {
+				expiresInDays = types.Int64Value(days)
+			}
+			items = append(items, ServiceAccountTokensItemModel{
+				ID:                 types.StringValue(tok.ID),
+				ServiceAccountID:   types.StringValue(sa.ID),
+				ServiceAccountName: types.StringValue(sa.Name),
+				Description:        types.StringValue(tok.Description),
+				CreatedAt:          types.StringValue(tok.CreatedAt.Format(time.RFC3339)),
+				LastUsed:           types.StringValue(formatOptionalTime(tok.LastUsed)),
+				Expiration:         types.StringValue(formatOptionalTime(tok.Expiration)),
+				ExpiresInDays:      expiresInDays,
+				IsExpired:          types.BoolValue(expired),
+				IsStale:            types.BoolValue(tokenStale(tok, now, staleAfterDays)),
+			})
+		}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serviceAccountTokensItemAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Tokens = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// tokenExpiresInDays returns the whole days between now and the expiration of
+// tok, rounded down: a token expiring within a day reports 0 and one that
+// expired less than a day ago reports -1. ok is false for tokens without an
+// expiration.
+func tokenExpiresInDays(tok ServiceAccountTokenObject, now time.Time) (days int64, ok bool) {
+	if tok.Expiration == nil {
+		return 0, false
+	}
+	return int64(math.Floor(tok.Expiration.Sub(now).Hours() / 24)), true
+}
+
+// tokenStale reports whether tok has not been used for staleAfterDays. A
+// token that was never used counts from its creation.
+func tokenStale(tok ServiceAccountTokenObject, now time.Time, staleAfterDays int64) bool {
+	lastActivity := tok.CreatedAt
+	if tok.LastUsed != nil {
+		lastActivity = *tok.LastUsed
+	}
+	return now.Sub(lastActivity) >= time.Duration(staleAfterDays)*24*time.Hour
+}
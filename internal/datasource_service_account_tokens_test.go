@@ -0,0 +1,150 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenExpiresInDays(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time { v := now.Add(d); return &v }
+
+	_, ok := tokenExpiresInDays(ServiceAccountTokenObject{}, now)
+	assert.False(t, ok)
+
+	tests := []struct {
+		expiration *time.Time
+		want       int64
+	}{
+		{expiration: at(10*24*time.Hour + time.Hour), want: 10},
+		{expiration: at(time.Hour), want: 0},
+		// Expired less than a day ago: negative, not the same as expiring today.
+		{expiration: at(-time.Minute), want: -1},
+		{expiration: at(-time.Hour), want: -1},
+		{expiration: at(-3 * 24 * time.Hour), want: -3},
+		{expiration: at(-3*24*time.Hour - time.Hour), want: -4},
+	}
+	for _, tt := range tests {
+		days, ok := tokenExpiresInDays(ServiceAccountTokenObject{Expiration: tt.expiration}, now)
+		assert.True(t, ok)
+		assert.Equal(t, tt.want, days, tt.expiration)
+	}
+}
+
+func TestTokenStale(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(n int) time.Time { return now.Add(-time.Duration(n) * 24 * time.Hour) }
+	ptr := func(v time.Time) *time.Time { return &v }
+
+	assert.False(t, tokenStale(ServiceAccountTokenObject{CreatedAt: daysAgo(100), LastUsed: ptr(daysAgo(5))}, now, 30))
+	assert.True(t, tokenStale(ServiceAccountTokenObject{CreatedAt: daysAgo(100), LastUsed: ptr(daysAgo(30))}, now, 30))
+	// Never used: counted from creation.
+	assert.False(t, tokenStale(ServiceAccountTokenObject{CreatedAt: daysAgo(10)}, now, 30))
+	assert.True(t, tokenStale(ServiceAccountTokenObject{CreatedAt: daysAgo(40)}, now, 30))
+}
+
+func readServiceAccountTokensDataSource(t *testing.T, config ServiceAccountTokensDataSourceModel) ([]ServiceAccountTokensItemModel, *datasource.ReadResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	ts := func(days int) string {
+		return time.Now().Add(time.Duration(days) * 24 * time.Hour).UTC().Format(time.RFC3339)
+	}
+	mock := &MockServiceAccountRoundTripper{
+		accounts: `[
+			{"id": "sa-1", "name": "ci", "is_active": true, "created_at": "2025-01-01T10:00:00Z"},
+			{"id": "sa-2", "name": "deployer", "is_active": true, "created_at": "2025-01-01T10:00:00Z"}
+		]`,
+		tokens: map[string]string{
+			"sa-1": `[
+				{"id": "tok-1", "description": "fresh", "created_at": "` + ts(-200) + `",
+				 "last_used": "` + ts(-1) + `", "expiration": "` + ts(30) + `"},
+				{"id": "tok-2", "description": "expired", "created_at": "` + ts(-400) + `",
+				 "last_used": null, "expiration": "` + ts(-10) + `"}
+			]`,
+			"sa-2": `[
+				{"id": "tok-3", "description": "unused", "created_at": "` + ts(-45) + `",
+				 "last_used": null, "expiration": null}
+			]`,
+		},
+	}
+	d := &ServiceAccountTokensDataSource{provider: &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	config.Tokens = types.ListNull(types.ObjectType{AttrTypes: serviceAccountTokensItemAttrTypes})
+	stateForConfig := tfsdk.State{Schema: schemaResp.Schema}
+	require.Empty(t, stateForConfig.Set(ctx, &config))
+
+	response := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: stateForConfig.Raw},
+	}, response)
+
+	var items []ServiceAccountTokensItemModel
+	if !response.Diagnostics.HasError() {
+		var out ServiceAccountTokensDataSourceModel
+		require.Empty(t, response.State.Get(ctx, &out))
+		require.Empty(t, out.Tokens.ElementsAs(ctx, &items, false))
+	}
+	return items, response
+}
+
+func TestServiceAccountTokensDataSource_Read(t *testing.T) {
+	items, response := readServiceAccountTokensDataSource(t, ServiceAccountTokensDataSourceModel{
+		ServiceAccountID: types.StringNull(),
+		StaleAfterDays:   types.Int64Value(30),
+		IncludeExpired:   types.BoolNull(),
+	})
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+	require.Len(t, items, 2)
+
+	assert.Equal(t, "tok-1", items[0].ID.ValueString())
+	assert.Equal(t, "ci", items[0].ServiceAccountName.ValueString())
+	assert.Equal(t, int64(29), items[0].ExpiresInDays.ValueInt64())
+	assert.False(t, items[0].IsStale.ValueBool())
+
+	assert.Equal(t, "tok-3", items[1].ID.ValueString())
+	assert.Equal(t, "sa-2", items[1].ServiceAccountID.ValueString())
+	assert.True(t, items[1].ExpiresInDays.IsNull())
+	assert.True(t, items[1].IsStale.ValueBool())
+}
+
+func TestServiceAccountTokensDataSource_ReadOneAccountWithExpired(t *testing.T) {
+	items, response := readServiceAccountTokensDataSource(t, ServiceAccountTokensDataSourceModel{
+		ServiceAccountID: types.StringValue("sa-1"),
+		StaleAfterDays:   types.Int64Null(),
+		IncludeExpired:   types.BoolValue(true),
+	})
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+	require.Len(t, items, 2)
+
+	assert.Equal(t, "tok-2", items[1].ID.ValueString())
+	assert.True(t, items[1].IsExpired.ValueBool())
+	// Expired just over 10 days ago; partial days round down.
+	assert.Equal(t, int64(-11), items[1].ExpiresInDays.ValueInt64())
+	assert.True(t, items[1].IsStale.ValueBool())
+}
+
+func TestServiceAccountTokensDataSource_ReadUnknownAccount(t *testing.T) {
+	_, response := readServiceAccountTokensDataSource(t, ServiceAccountTokensDataSourceModel{
+		ServiceAccountID: types.StringValue("missing"),
+		StaleAfterDays:   types.Int64Null(),
+		IncludeExpired:   types.BoolNull(),
+	})
+	require.True(t, response.Diagnostics.HasError())
+	assert.Equal(t, "Service account not found", response.Diagnostics.Errors()[0].Summary())
+}
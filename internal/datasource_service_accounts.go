@@ -0,0 +1,260 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServiceAccountsDataSource{}
+
+func NewServiceAccountsDataSource() datasource.DataSource {
+	return &ServiceAccountsDataSource{}
+}
+
+// ServiceAccountsDataSource lists service accounts with their active tokens
+// for audits.
+type ServiceAccountsDataSource struct {
+	provider *InfradotsProvider
+}
+
+type ServiceAccountsDataSourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	IncludeInactive  types.Bool   `tfsdk:"include_inactive"`
+	ServiceAccounts  types.List   `tfsdk:"service_accounts"`
+}
+
+type ServiceAccountsItemModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Scopes            types.List   `tfsdk:"scopes"`
+	OrganizationNames types.List   `tfsdk:"organization_names"`
+	IsActive          types.Bool   `tfsdk:"is_active"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	ActiveTokens      types.List   `tfsdk:"active_tokens"`
+}
+
+type ServiceAccountsTokenModel struct {
+	ID          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	LastUsed    types.String `tfsdk:"last_used"`
+	Expiration  types.String `tfsdk:"expiration"`
+}
+
+var serviceAccountsTokenAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"description": types.StringType,
+	"created_at":  types.StringType,
+	"last_used":   types.StringType,
+	"expiration":  types.StringType,
+}
+
+var serviceAccountsItemAttrTypes = map[string]attr.Type{
+	"id":                 types.StringType,
+	"name":               types.StringType,
+	"description":        types.StringType,
+	"scopes":             types.ListType{ElemType: types.StringType},
+	"organization_names": types.ListType{ElemType: types.StringType},
+	"is_active":          types.BoolType,
+	"created_at":         types.StringType,
+	"active_tokens":      types.ListType{ElemType: types.ObjectType{AttrTypes: serviceAccountsTokenAttrTypes}},
+}
+
+func (d *ServiceAccountsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_accounts_data"
+}
+
+func (d *ServiceAccountsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists service accounts and their active (unexpired) tokens (admin-only).",
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				Description: "Only list service accounts that can be used in this organization.",
+				Optional:    true,
+			},
+			"include_inactive": schema.BoolAttribute{
+				Description: "Also list deactivated service accounts. Defaults to false.",
+				Optional:    true,
+			},
+			"service_accounts": schema.ListNestedAttribute{
+				Description: "The service accounts, in the order returned by the API.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The service account unique ID (UUID).",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the service account.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the service account.",
+							Computed:    true,
+						},
+						"scopes": schema.ListAttribute{
+							Description: "Scopes assigned to the service account.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"organization_names": schema.ListAttribute{
+							Description: "Organizations the service account is restricted to; empty if it is not restricted.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"is_active": schema.BoolAttribute{
+							Description: "Whether the service account is active.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp when the service account was created.",
+							Computed:    true,
+						},
+						"active_tokens": schema.ListNestedAttribute{
+							Description: "Tokens of the service account that have not expired.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The token unique ID (UUID).",
+										Computed:    true,
+									},
+									"description": schema.StringAttribute{
+										Description: "Description of the token.",
+										Computed:    true,
+									},
+									"created_at": schema.StringAttribute{
+										Description: "The timestamp when the token was created.",
+										Computed:    true,
+									},
+									"last_used": schema.StringAttribute{
+										Description: "The timestamp when the token was last used; empty if it was never used.",
+										Computed:    true,
+									},
+									"expiration": schema.StringAttribute{
+										Description: "The expiration date of the token; empty if it does not expire.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ServiceAccountsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*InfradotsProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *InfradotsProvider, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.provider = provider
+}
+
+func (d *ServiceAccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServiceAccountsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accounts, err := listServiceAccounts(d.provider)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing service accounts", err.Error())
+		return
+	}
+
+	now := time.Now()
+	items := []ServiceAccountsItemModel{}
+	for _, sa := range accounts {
+		if !sa.IsActive && !data.IncludeInactive.ValueBool() {
+			continue
+		}
+		if org := data.OrganizationName.ValueString(); org != "" && !serviceAccountAllowsOrganization(sa, org) {
+			continue
+		}
+
+		tokens, err := listServiceAccountTokens(d.provider, sa.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error listing tokens of service account %s", sa.Name), err.Error())
+			return
+		}
+		tokenModels := []ServiceAccountsTokenModel{}
+		for _, tok := range tokens {
+			if tokenExpired(tok, now) {
+				continue
+			}
+			tokenModels = append(tokenModels, ServiceAccountsTokenModel{
+				ID:          types.StringValue(tok.ID),
+				Description: types.StringValue(tok.Description),
+				CreatedAt:   types.StringValue(tok.CreatedAt.Format(time.RFC3339)),
+				LastUsed:    types.StringValue(formatOptionalTime(tok.LastUsed)),
+				Expiration:  types.StringValue(formatOptionalTime(tok.Expiration)),
+			})
+		}
+
+		if sa.Scopes == nil {
+			sa.Scopes = []string{}
+		}
+		if sa.OrganizationNames == nil {
+			sa.OrganizationNames = []string{}
+		}
+		scopes, diags := types.ListValueFrom(ctx, types.StringType, sa.Scopes)
+		resp.Diagnostics.Append(diags...)
+		orgs, diags := types.ListValueFrom(ctx, types.StringType, sa.OrganizationNames)
+		resp.Diagnostics.Append(diags...)
+		activeTokens, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serviceAccountsTokenAttrTypes}, tokenModels)
+		resp.Diagnostics.Append(diags...)
+
+		items = append(items, ServiceAccountsItemModel{
+			ID:                types.StringValue(sa.ID),
+			Name:              types.StringValue(sa.Name),
+			Description:       types.StringValue(sa.Description),
+			Scopes:            scopes,
+			OrganizationNames: orgs,
+			IsActive:          types.BoolValue(sa.IsActive),
+			CreatedAt:         types.StringValue(sa.CreatedAt.Format(time.RFC3339)),
+			ActiveTokens:      activeTokens,
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serviceAccountsItemAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ServiceAccounts = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// serviceAccountAllowsOrganization reports whether sa can be used in org.
+// Accounts without an organization restriction can be used everywhere.
+func serviceAccountAllowsOrganization(sa ServiceAccountAPIResponse, org string) bool {
+	if len(sa.OrganizationNames) == 0 {
+		return true
+	}
+	for _, name := range sa.OrganizationNames {
+		if name == org {
+			return true
+		}
+	}
+	return false
+}
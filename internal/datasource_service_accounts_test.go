@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readServiceAccountsDataSource(t *testing.T, config ServiceAccountsDataSourceModel) (ServiceAccountsDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	mock := &MockServiceAccountRoundTripper{
+		accounts: `[
+			{"id": "sa-1", "name": "ci", "scopes": ["read_workspaces"], "is_active": true,
+			 "created_at": "2025-01-01T10:00:00Z"},
+			{"id": "sa-2", "name": "deployer", "scopes": ["write_workspaces"], "is_active": true,
+			 "organization_names": ["other-org"], "created_at": "2025-01-01T10:00:00Z"},
+			{"id": "sa-3", "name": "retired", "scopes": [], "is_active": false,
+			 "created_at": "2024-01-01T10:00:00Z"}
+		]`,
+		tokens: map[string]string{
+			"sa-1": `[
+				{"id": "tok-1", "description": "current", "created_at": "2025-01-01T10:00:00Z",
+				 "last_used": "2025-02-01T10:00:00Z", "expiration": null},
+				{"id": "tok-2", "description": "expired", "created_at": "2024-01-01T10:00:00Z",
+				 "last_used": null, "expiration": "2024-06-01T00:00:00Z"}
+			]`,
+		},
+	}
+	d := &ServiceAccountsDataSource{provider: &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	config.ServiceAccounts = types.ListNull(types.ObjectType{AttrTypes: serviceAccountsItemAttrTypes})
+	stateForConfig := tfsdk.State{Schema: schemaResp.Schema}
+	require.Empty(t, stateForConfig.Set(ctx, &config))
+
+	response := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: stateForConfig.Raw},
+	}, response)
+
+	var out ServiceAccountsDataSourceModel
+	if !response.Diagnostics.HasError() {
+		require.Empty(t, response.State.Get(ctx, &out))
+	}
+	return out, response
+}
+
+func TestServiceAccountsDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	out, response := readServiceAccountsDataSource(t, ServiceAccountsDataSourceModel{
+		OrganizationName: types.StringNull(),
+		IncludeInactive:  types.BoolNull(),
+	})
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+
+	var accounts []ServiceAccountsItemModel
+	require.Empty(t, out.ServiceAccounts.ElementsAs(ctx, &accounts, false))
+	require.Len(t, accounts, 2)
+	assert.Equal(t, "ci", accounts[0].Name.ValueString())
+	assert.Equal(t, "deployer", accounts[1].Name.ValueString())
+
+	var tokens []ServiceAccountsTokenModel
+	require.Empty(t, accounts[0].ActiveTokens.ElementsAs(ctx, &tokens, false))
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "tok-1", tokens[0].ID.ValueString())
+	assert.Equal(t, "2025-02-01T10:00:00Z", tokens[0].LastUsed.ValueString())
+	assert.Equal(t, "", tokens[0].Expiration.ValueString())
+}
+
+func TestServiceAccountsDataSource_ReadFilters(t *testing.T) {
+	ctx := context.Background()
+	out, response := readServiceAccountsDataSource(t, ServiceAccountsDataSourceModel{
+		OrganizationName: types.StringValue("test-org"),
+		IncludeInactive:  types.BoolValue(true),
+	})
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+
+	// deployer is restricted to another organization.
+	var accounts []ServiceAccountsItemModel
+	require.Empty(t, out.ServiceAccounts.ElementsAs(ctx, &accounts, false))
+	require.Len(t, accounts, 2)
+	assert.Equal(t, "ci", accounts[0].Name.ValueString())
+	assert.Equal(t, "retired", accounts[1].Name.ValueString())
+	assert.False(t, accounts[1].IsActive.ValueBool())
+}
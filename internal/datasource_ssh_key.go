@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SSHKeyDataSource{}
+
+func NewSSHKeyDataSource() datasource.DataSource {
+	return &SSHKeyDataSource{}
+}
+
+type SSHKeyDataSource struct {
+	provider *InfradotsProvider
+}
+
+type SSHKeyDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationName types.String `tfsdk:"organization_name"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	KeyType          types.String `tfsdk:"key_type"`
+	PublicKey        types.String `tfsdk:"public_key"`
+	Fingerprint      types.String `tfsdk:"fingerprint"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+func (d *SSHKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key_data"
+}
+
+func (d *SSHKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches an SSH key by ID or by organization name and key name. The private key is never returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique ID of the SSH key.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the SSH key.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the SSH key.",
+				Computed:    true,
+			},
+			"key_type": schema.StringAttribute{
+				Description: "The type of the key, e.g. ed25519 or rsa.",
+				Computed:    true,
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The public key in OpenSSH authorized_keys format.",
+				Computed:    true,
+			},
+			"fingerprint": schema.StringAttribute{
+				Description: "The SHA256 fingerprint of the key.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the key was created.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *SSHKeyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*InfradotsProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *InfradotsProvider, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.provider = provider
+}
+
+func (d *SSHKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SSHKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgName := data.OrganizationName.ValueString()
+
+	var key *SSHKeyAPIResponse
+	switch {
+	case data.ID.ValueString() != "":
+		var err error
+		key, _, err = fetchSSHKey(d.provider, orgName, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Read failed", err.Error())
+			return
+		}
+	case data.Name.ValueString() != "":
+		keys, err := listSSHKeys(d.provider, orgName)
+		if err != nil {
+			resp.Diagnostics.AddError("Read failed", err.Error())
+			return
+		}
+		for i := range keys {
+			if keys[i].Name == data.Name.ValueString() {
+				key = &keys[i]
+				break
+			}
+		}
+		if key == nil {
+			resp.Diagnostics.AddError(
+				"SSH key not found",
+				fmt.Sprintf("No SSH key named '%s' found in organization '%s'", data.Name.ValueString(), orgName),
+			)
+			return
+		}
+	default:
+		resp.Diagnostics.AddError("Missing required parameter", "Either id or name must be specified")
+		return
+	}
+
+	data.ID = types.StringValue(key.ID)
+	data.Name = types.StringValue(key.Name)
+	data.Description = types.StringValue(key.Description)
+	data.KeyType = types.StringValue(key.KeyType)
+	data.PublicKey = types.StringValue(key.PublicKey)
+	data.Fingerprint = types.StringValue(key.Fingerprint)
+	data.CreatedAt = types.StringValue(key.CreatedAt.Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
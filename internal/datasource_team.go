@@ -27,6 +27,22 @@ type TeamDataSourceModel struct {
 	OrganizationName types.String `tfsdk:"organization_name"`
 	Name             types.String `tfsdk:"name"`
 	Members          types.List   `tfsdk:"members"`
+	Maintainers      types.List   `tfsdk:"maintainers"`
+	Description      types.String `tfsdk:"description"`
+	Visibility       types.String `tfsdk:"visibility"`
+	Permissions      types.List   `tfsdk:"permissions"`
+}
+
+func (m *TeamDataSourceModel) setFromAPI(team TeamAPIResponse) {
+	var r TeamResourceModel
+	mapTeamResponseToModel(&r, team)
+	m.ID = r.ID
+	m.Name = r.Name
+	m.Members = r.Members
+	m.Maintainers = r.Maintainers
+	m.Description = r.Description
+	m.Visibility = r.Visibility
+	m.Permissions = r.Permissions
 }
 
 func (d *TeamDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -56,6 +72,35 @@ func (d *TeamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"maintainers": schema.ListAttribute{
+				Description: "Email addresses of the members with the maintainer role.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the team.",
+				Computed:    true,
+			},
+			"visibility": schema.StringAttribute{
+				Description: "The visibility of the team: visible or secret.",
+				Computed:    true,
+			},
+			"permissions": schema.ListNestedAttribute{
+				Description: "Permissions granted to the team.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission": schema.StringAttribute{
+							Description: "The permission codename.",
+							Computed:    true,
+						},
+						"workspace": schema.StringAttribute{
+							Description: "The workspace the permission is scoped to; empty for organization-level permissions.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -121,9 +166,7 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			return
 		}
 
-		data.ID = types.StringValue(team.ID)
-		data.Name = types.StringValue(team.Name)
-		data.Members = teamMembersToList(team.Members)
+		data.setFromAPI(team)
 	} else if !data.Name.IsNull() && data.Name.ValueString() != "" {
 		// List and filter by name
 		url := fmt.Sprintf("https://%s/api/organizations/%s/teams/",
@@ -164,9 +207,7 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		found := false
 		for _, team := range teams {
 			if team.Name == data.Name.ValueString() {
-				data.ID = types.StringValue(team.ID)
-				data.Name = types.StringValue(team.Name)
-				data.Members = teamMembersToList(team.Members)
+				data.setFromAPI(team)
 				found = true
 				break
 			}
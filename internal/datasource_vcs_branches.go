@@ -0,0 +1,170 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &VCSBranchesDataSource{}
+
+func NewVCSBranchesDataSource() datasource.DataSource {
+	return &VCSBranchesDataSource{}
+}
+
+// VCSBranchesDataSource lists the branches of a repository reachable through
+// a VCS connection, so workspace branches can be validated.
+type VCSBranchesDataSource struct {
+	provider *InfradotsProvider
+}
+
+type VCSBranchesDataSourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	VcsID            types.String `tfsdk:"vcs_id"`
+	Repository       types.String `tfsdk:"repository"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	Branches         types.List   `tfsdk:"branches"`
+}
+
+type VCSBranchesItemModel struct {
+	Name      types.String `tfsdk:"name"`
+	IsDefault types.Bool   `tfsdk:"is_default"`
+	CommitSHA types.String `tfsdk:"commit_sha"`
+}
+
+var vcsBranchesItemAttrTypes = map[string]attr.Type{
+	"name":       types.StringType,
+	"is_default": types.BoolType,
+	"commit_sha": types.StringType,
+}
+
+// VCSBranchAPIResponse is a branch of a repository reachable through a VCS
+// connection.
+type VCSBranchAPIResponse struct {
+	Name      string `json:"name"`
+	IsDefault bool   `json:"isDefault"`
+	CommitSHA string `json:"commitSha"`
+}
+
+func (d *VCSBranchesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vcs_branches_data"
+}
+
+func (d *VCSBranchesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the branches of a repository reachable through a VCS connection.",
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization the VCS connection belongs to.",
+				Required:    true,
+			},
+			"vcs_id": schema.StringAttribute{
+				Description: "The ID of the VCS connection.",
+				Required:    true,
+			},
+			"repository": schema.StringAttribute{
+				Description: "The full name of the repository, e.g. example/terraform-config, as reported by infradots_vcs_repositories_data.",
+				Required:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only list branches whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"branches": schema.ListNestedAttribute{
+				Description: "The branches of the repository.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the branch.",
+							Computed:    true,
+						},
+						"is_default": schema.BoolAttribute{
+							Description: "Whether this is the default branch of the repository.",
+							Computed:    true,
+						},
+						"commit_sha": schema.StringAttribute{
+							Description: "The SHA of the latest commit on the branch.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VCSBranchesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*InfradotsProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *InfradotsProvider, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.provider = provider
+}
+
+func (d *VCSBranchesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VCSBranchesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, diags := compileNameRegex(data.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Repository names contain slashes, so they are passed as a query parameter.
+	url := fmt.Sprintf("https://%s/api/organizations/%s/vcs/%s/branches/?repository=%s",
+		d.provider.host, data.OrganizationName.ValueString(), data.VcsID.ValueString(),
+		neturl.QueryEscape(data.Repository.ValueString()))
+	var branches []VCSBranchAPIResponse
+	statusCode, err := getVCSBrowserJSON(d.provider, url, &branches)
+	if statusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Repository not found",
+			fmt.Sprintf("No repository '%s' is reachable through VCS connection '%s' in organization '%s'",
+				data.Repository.ValueString(), data.VcsID.ValueString(), data.OrganizationName.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing branches", err.Error())
+		return
+	}
+
+	items := []VCSBranchesItemModel{}
+	for _, branch := range branches {
+		if nameRegex != nil && !nameRegex.MatchString(branch.Name) {
+			continue
+		}
+		items = append(items, VCSBranchesItemModel{
+			Name:      types.StringValue(branch.Name),
+			IsDefault: types.BoolValue(branch.IsDefault),
+			CommitSHA: types.StringValue(branch.CommitSHA),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: vcsBranchesItemAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Branches = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,222 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &VCSRepositoriesDataSource{}
+
+func NewVCSRepositoriesDataSource() datasource.DataSource {
+	return &VCSRepositoriesDataSource{}
+}
+
+// VCSRepositoriesDataSource lists the repositories reachable through a VCS
+// connection, so workspace sources can be derived rather than hard-coded.
+type VCSRepositoriesDataSource struct {
+	provider *InfradotsProvider
+}
+
+type VCSRepositoriesDataSourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	VcsID            types.String `tfsdk:"vcs_id"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	Repositories     types.List   `tfsdk:"repositories"`
+}
+
+type VCSRepositoriesItemModel struct {
+	Name          types.String `tfsdk:"name"`
+	FullName      types.String `tfsdk:"full_name"`
+	URL           types.String `tfsdk:"url"`
+	DefaultBranch types.String `tfsdk:"default_branch"`
+	Private       types.Bool   `tfsdk:"private"`
+}
+
+var vcsRepositoriesItemAttrTypes = map[string]attr.Type{
+	"name":           types.StringType,
+	"full_name":      types.StringType,
+	"url":            types.StringType,
+	"default_branch": types.StringType,
+	"private":        types.BoolType,
+}
+
+// VCSRepositoryAPIResponse is a repository reachable through a VCS connection.
+type VCSRepositoryAPIResponse struct {
+	Name          string `json:"name"`
+	FullName      string `json:"fullName"`
+	URL           string `json:"url"`
+	DefaultBranch string `json:"defaultBranch"`
+	Private       bool   `json:"private"`
+}
+
+func (d *VCSRepositoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vcs_repositories_data"
+}
+
+func (d *VCSRepositoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the repositories reachable through a VCS connection.",
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization the VCS connection belongs to.",
+				Required:    true,
+			},
+			"vcs_id": schema.StringAttribute{
+				Description: "The ID of the VCS connection.",
+				Required:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only list repositories whose full name (e.g. example/terraform-config) matches this regular expression.",
+				Optional:    true,
+			},
+			"repositories": schema.ListNestedAttribute{
+				Description: "The repositories, in the order returned by the VCS.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the repository.",
+							Computed:    true,
+						},
+						"full_name": schema.StringAttribute{
+							Description: "The name of the repository including its owner, group or project, e.g. example/terraform-config.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "The URL of the repository, usable as the source of a workspace.",
+							Computed:    true,
+						},
+						"default_branch": schema.StringAttribute{
+							Description: "The default branch of the repository.",
+							Computed:    true,
+						},
+						"private": schema.BoolAttribute{
+							Description: "Whether the repository is private.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VCSRepositoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*InfradotsProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *InfradotsProvider, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.provider = provider
+}
+
+func (d *VCSRepositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VCSRepositoriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, diags := compileNameRegex(data.NameRegex)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := fmt.Sprintf("https://%s/api/organizations/%s/vcs/%s/repositories/",
+		d.provider.host, data.OrganizationName.ValueString(), data.VcsID.ValueString())
+	var repositories []VCSRepositoryAPIResponse
+	statusCode, err := getVCSBrowserJSON(d.provider, url, &repositories)
+	if statusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"VCS connection not found",
+			fmt.Sprintf("No VCS connection with ID '%s' found in organization '%s'", data.VcsID.ValueString(), data.OrganizationName.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing repositories", err.Error())
+		return
+	}
+
+	items := []VCSRepositoriesItemModel{}
+	for _, repo := range repositories {
+		if nameRegex != nil && !nameRegex.MatchString(repo.FullName) {
+			continue
+		}
+		items = append(items, VCSRepositoriesItemModel{
+			Name:          types.StringValue(repo.Name),
+			FullName:      types.StringValue(repo.FullName),
+			URL:           types.StringValue(repo.URL),
+			DefaultBranch: types.StringValue(repo.DefaultBranch),
+			Private:       types.BoolValue(repo.Private),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: vcsRepositoriesItemAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Repositories = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// compileNameRegex compiles the name_regex filter; it returns nil when the
+// filter is not set.
+func compileNameRegex(value types.String) (*regexp.Regexp, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.ValueString() == "" {
+		return nil, diags
+	}
+	re, err := regexp.Compile(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+		return nil, diags
+	}
+	return re, diags
+}
+
+// getVCSBrowserJSON fetches url from the repository browser of a VCS
+// connection into v. The status code is returned so callers can tell a
+// missing connection or repository from other failures.
+func getVCSBrowserJSON(p *InfradotsProvider, url string, v interface{}) (int, error) {
+	httpReq, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return httpResp.StatusCode, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return httpResp.StatusCode, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(body))
+	}
+	return httpResp.StatusCode, json.Unmarshal(body, v)
+}
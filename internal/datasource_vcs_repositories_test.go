@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockVCSBrowserRoundTripper serves the repositories and branches of the VCS
+// connection vcs-1 in test-org.
+type MockVCSBrowserRoundTripper struct{}
+
+func (m *MockVCSBrowserRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader(`{"error": "Not found"}`)),
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	var body string
+	switch {
+	case req.URL.Path == "/api/organizations/test-org/vcs/vcs-1/repositories/":
+		body = `[
+			{"name": "terraform-config", "fullName": "example/terraform-config",
+			 "url": "https://github.com/example/terraform-config", "defaultBranch": "main", "private": true},
+			{"name": "website", "fullName": "example/website",
+			 "url": "https://github.com/example/website", "defaultBranch": "master", "private": false}
+		]`
+	case req.URL.Path == "/api/organizations/test-org/vcs/vcs-1/branches/" &&
+		req.URL.Query().Get("repository") == "example/terraform-config":
+		body = `[
+			{"name": "main", "isDefault": true, "commitSha": "abc123"},
+			{"name": "release/1.0", "isDefault": false, "commitSha": "def456"},
+			{"name": "feature/x", "isDefault": false, "commitSha": "0a1b2c"}
+		]`
+	default:
+		return resp, nil
+	}
+	resp.StatusCode = http.StatusOK
+	resp.Body = io.NopCloser(strings.NewReader(body))
+	return resp, nil
+}
+
+func testVCSBrowserProvider() *InfradotsProvider {
+	return &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: &MockVCSBrowserRoundTripper{}},
+	}
+}
+
+func TestVCSRepositoriesDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	d := &VCSRepositoriesDataSource{provider: testVCSBrowserProvider()}
+
+	read := func(vcsID string, nameRegex types.String) ([]VCSRepositoriesItemModel, *datasource.ReadResponse) {
+		var out VCSRepositoriesDataSourceModel
+		response := readTestDataSource(t, d, &VCSRepositoriesDataSourceModel{
+			OrganizationName: types.StringValue("test-org"),
+			VcsID:            types.StringValue(vcsID),
+			NameRegex:        nameRegex,
+			Repositories:     types.ListNull(types.ObjectType{AttrTypes: vcsRepositoriesItemAttrTypes}),
+		}, &out)
+		var items []VCSRepositoriesItemModel
+		if !response.Diagnostics.HasError() {
+			require.Empty(t, out.Repositories.ElementsAs(ctx, &items, false))
+		}
+		return items, response
+	}
+
+	items, response := read("vcs-1", types.StringNull())
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+	require.Len(t, items, 2)
+	assert.Equal(t, "https://github.com/example/terraform-config", items[0].URL.ValueString())
+	assert.Equal(t, "main", items[0].DefaultBranch.ValueString())
+	assert.True(t, items[0].Private.ValueBool())
+
+	items, response = read("vcs-1", types.StringValue("^example/terraform-"))
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+	require.Len(t, items, 1)
+	assert.Equal(t, "terraform-config", items[0].Name.ValueString())
+
+	_, response = read("vcs-1", types.StringValue("("))
+	require.True(t, response.Diagnostics.HasError())
+	assert.Equal(t, "Invalid regular expression", response.Diagnostics.Errors()[0].Summary())
+
+	_, response = read("missing", types.StringNull())
+	require.True(t, response.Diagnostics.HasError())
+	assert.Equal(t, "VCS connection not found", response.Diagnostics.Errors()[0].Summary())
+}
+
+func TestVCSBranchesDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	d := &VCSBranchesDataSource{provider: testVCSBrowserProvider()}
+
+	read := func(repository string, nameRegex types.String) ([]VCSBranchesItemModel, *datasource.ReadResponse) {
+		var out VCSBranchesDataSourceModel
+		response := readTestDataSource(t, d, &VCSBranchesDataSourceModel{
+			OrganizationName: types.StringValue("test-org"),
+			VcsID:            types.StringValue("vcs-1"),
+			Repository:       types.StringValue(repository),
+			NameRegex:        nameRegex,
+			Branches:         types.ListNull(types.ObjectType{AttrTypes: vcsBranchesItemAttrTypes}),
+		}, &out)
+		var items []VCSBranchesItemModel
+		if !response.Diagnostics.HasError() {
+			require.Empty(t, out.Branches.ElementsAs(ctx, &items, false))
+		}
+		return items, response
+	}
+
+	items, response := read("example/terraform-config", types.StringNull())
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+	require.Len(t, items, 3)
+	assert.Equal(t, "main", items[0].Name.ValueString())
+	assert.True(t, items[0].IsDefault.ValueBool())
+	assert.Equal(t, "abc123", items[0].CommitSHA.ValueString())
+
+	items, response = read("example/terraform-config", types.StringValue("^release/"))
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+	require.Len(t, items, 1)
+	assert.Equal(t, "release/1.0", items[0].Name.ValueString())
+
+	_, response = read("example/missing", types.StringNull())
+	require.True(t, response.Diagnostics.HasError())
+	assert.Equal(t, "Repository not found", response.Diagnostics.Errors()[0].Summary())
+}
@@ -0,0 +1,153 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &VCSStatusDataSource{}
+
+func NewVCSStatusDataSource() datasource.DataSource {
+	return &VCSStatusDataSource{}
+}
+
+// VCSStatusDataSource runs the health check of a VCS connection, e.g. to
+// detect expired OAuth tokens before workspaces fail to clone.
+type VCSStatusDataSource struct {
+	provider *InfradotsProvider
+}
+
+type VCSStatusDataSourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	VcsID            types.String `tfsdk:"vcs_id"`
+	Connected        types.Bool   `tfsdk:"connected"`
+	LastChecked      types.String `tfsdk:"last_checked"`
+	ErrorMessage     types.String `tfsdk:"error_message"`
+	RepositoryCount  types.Int64  `tfsdk:"repository_count"`
+}
+
+// VCSStatusAPIResponse is the result of the health check of a VCS connection.
+type VCSStatusAPIResponse struct {
+	Connected       bool       `json:"connected"`
+	LastChecked     *time.Time `json:"lastChecked"`
+	ErrorMessage    string     `json:"errorMessage"`
+	RepositoryCount int64      `json:"repositoryCount"`
+}
+
+func (d *VCSStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vcs_status_data"
+}
+
+func (d *VCSStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks whether a VCS connection can still reach the VCS with its credentials. A failing check is reported in the attributes rather than as an error, so it can be asserted in check blocks.",
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization the VCS connection belongs to.",
+				Required:    true,
+			},
+			"vcs_id": schema.StringAttribute{
+				Description: "The ID of the VCS connection to check.",
+				Required:    true,
+			},
+			"connected": schema.BoolAttribute{
+				Description: "Whether the platform could authenticate to the VCS.",
+				Computed:    true,
+			},
+			"last_checked": schema.StringAttribute{
+				Description: "The timestamp of the check.",
+				Computed:    true,
+			},
+			"error_message": schema.StringAttribute{
+				Description: "Why the check failed, e.g. an expired or revoked token; empty when connected.",
+				Computed:    true,
+			},
+			"repository_count": schema.Int64Attribute{
+				Description: "The number of repositories accessible through the connection; 0 when not connected.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *VCSStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*InfradotsProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *InfradotsProvider, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.provider = provider
+}
+
+func (d *VCSStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VCSStatusDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, statusCode, err := fetchVCSStatus(d.provider, data.OrganizationName.ValueString(), data.VcsID.ValueString())
+	if statusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"VCS connection not found",
+			fmt.Sprintf("No VCS connection with ID '%s' found in organization '%s'", data.VcsID.ValueString(), data.OrganizationName.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error checking VCS connection", err.Error())
+		return
+	}
+
+	data.Connected = types.BoolValue(status.Connected)
+	data.LastChecked = types.StringValue(formatOptionalTime(status.LastChecked))
+	data.ErrorMessage = types.StringValue(status.ErrorMessage)
+	data.RepositoryCount = types.Int64Value(status.RepositoryCount)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fetchVCSStatus runs the health check of a VCS connection. The status code is
+// returned so callers can tell a missing connection from other failures.
+func fetchVCSStatus(p *InfradotsProvider, org, vcsID string) (*VCSStatusAPIResponse, int, error) {
+	url := fmt.Sprintf("https://%s/api/organizations/%s/vcs/%s/status/", p.host, org, vcsID)
+	httpReq, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, httpResp.StatusCode, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, httpResp.StatusCode, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(body))
+	}
+
+	var status VCSStatusAPIResponse
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, httpResp.StatusCode, err
+	}
+	return &status, httpResp.StatusCode, nil
+}
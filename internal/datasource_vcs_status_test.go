@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockVCSStatusRoundTripper answers the health check of the VCS connections
+// in statuses, keyed by ID.
+type MockVCSStatusRoundTripper struct {
+	statuses map[string]string
+}
+
+func (m *MockVCSStatusRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader(`{"error": "Not found"}`)),
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	id := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/api/organizations/test-org/vcs/"), "/status/")
+	if body, ok := m.statuses[id]; ok && req.Method == http.MethodGet {
+		resp.StatusCode = http.StatusOK
+		resp.Body = io.NopCloser(strings.NewReader(body))
+	}
+	return resp, nil
+}
+
+func readVCSStatusDataSource(t *testing.T, vcsID string) (VCSStatusDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	d := &VCSStatusDataSource{provider: &InfradotsProvider{
+		host:  "api.infradots.com",
+		token: "test-token",
+		client: &http.Client{Transport: &MockVCSStatusRoundTripper{statuses: map[string]string{
+			"vcs-ok": `{"connected": true, "lastChecked": "2025-03-01T12:00:00Z", "errorMessage": "", "repositoryCount": 42}`,
+			"vcs-expired": `{"connected": false, "lastChecked": "2025-03-01T12:00:00Z",
+				"errorMessage": "OAuth token expired", "repositoryCount": 0}`,
+		}}},
+	}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	config := VCSStatusDataSourceModel{
+		OrganizationName: types.StringValue("test-org"),
+		VcsID:            types.StringValue(vcsID),
+		Connected:        types.BoolNull(),
+		LastChecked:      types.StringNull(),
+		ErrorMessage:     types.StringNull(),
+		RepositoryCount:  types.Int64Null(),
+	}
+	stateForConfig := tfsdk.State{Schema: schemaResp.Schema}
+	require.Empty(t, stateForConfig.Set(ctx, &config))
+
+	response := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: stateForConfig.Raw},
+	}, response)
+
+	var out VCSStatusDataSourceModel
+	if !response.Diagnostics.HasError() {
+		require.Empty(t, response.State.Get(ctx, &out))
+	}
+	return out, response
+}
+
+func TestVCSStatusDataSource_Read(t *testing.T) {
+	out, response := readVCSStatusDataSource(t, "vcs-ok")
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+	assert.True(t, out.Connected.ValueBool())
+	assert.Equal(t, "2025-03-01T12:00:00Z", out.LastChecked.ValueString())
+	assert.Equal(t, "", out.ErrorMessage.ValueString())
+	assert.Equal(t, int64(42), out.RepositoryCount.ValueInt64())
+}
+
+func TestVCSStatusDataSource_ReadDisconnected(t *testing.T) {
+	// A failing check is data, not an error, so check blocks can assert it.
+	out, response := readVCSStatusDataSource(t, "vcs-expired")
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+	assert.False(t, out.Connected.ValueBool())
+	assert.Equal(t, "OAuth token expired", out.ErrorMessage.ValueString())
+	assert.Equal(t, int64(0), out.RepositoryCount.ValueInt64())
+}
+
+func TestVCSStatusDataSource_ReadNotFound(t *testing.T) {
+	_, response := readVCSStatusDataSource(t, "missing")
+	require.True(t, response.Diagnostics.HasError())
+	assert.Equal(t, "VCS connection not found", response.Diagnostics.Errors()[0].Summary())
+}
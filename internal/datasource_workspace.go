@@ -25,16 +25,17 @@ type WorkspaceDataSource struct {
 }
 
 type WorkspaceDataSourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	OrganizationName types.String `tfsdk:"organization_name"`
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	Source           types.String `tfsdk:"source"`
-	Branch           types.String `tfsdk:"branch"`
-	TerraformVersion types.String `tfsdk:"terraform_version"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	UpdatedAt        types.String `tfsdk:"updated_at"`
-	VCS              types.Object `tfsdk:"vcs"`
+	ID                types.String `tfsdk:"id"`
+	OrganizationName  types.String `tfsdk:"organization_name"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Source            types.String `tfsdk:"source"`
+	Branch            types.String `tfsdk:"branch"`
+	TerraformVersion  types.String `tfsdk:"terraform_version"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+	VCS               types.Object `tfsdk:"vcs"`
+	EffectiveSettings types.Object `tfsdk:"effective_settings"`
 }
 
 type WorkspaceDataSourceFilterModel struct {
@@ -124,6 +125,18 @@ func (d *WorkspaceDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 					},
 				},
 			},
+			"effective_settings": schema.SingleNestedAttribute{
+				Description: effectiveSettingsDescription,
+				Computed:    true,
+				Attributes: effectiveSettingsAttributes(
+					func(description string) schema.Attribute {
+						return schema.StringAttribute{Description: description, Computed: true}
+					},
+					func(description string) schema.Attribute {
+						return schema.BoolAttribute{Description: description, Computed: true}
+					},
+				),
+			},
 		},
 	}
 }
@@ -256,6 +269,7 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	// Process based on filter type
+	var matched WorkspaceAPIResponse
 	if !filter.ID.IsNull() {
 		// Single workspace response
 		var apiResp WorkspaceAPIResponse
@@ -276,6 +290,7 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		data.CreatedAt = types.StringValue(apiResp.CreatedAt.Format(time.RFC3339))
 		data.UpdatedAt = types.StringValue(apiResp.UpdatedAt.Format(time.RFC3339))
 		data.VCS = vcsToObjectDataSource(apiResp.VCS)
+		matched = apiResp
 	} else {
 		// List of workspaces, filter by name
 		var apiRespList []WorkspaceAPIResponse
@@ -288,6 +303,7 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		found := false
 		for _, workspace := range apiRespList {
 			if workspace.Name == filter.Name.ValueString() {
+				matched = workspace
 				// Map response to model
 				data.ID = types.StringValue(workspace.ID)
 				data.OrganizationName = filter.OrganizationName
@@ -315,6 +331,10 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		}
 	}
 
+	effectiveSettings, diags := effectiveSettingsFor(d.provider, data.OrganizationName.ValueString(), matched)
+	resp.Diagnostics.Append(diags...)
+	data.EffectiveSettings = effectiveSettings
+
 	// Set the data for the response
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
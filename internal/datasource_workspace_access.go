@@ -0,0 +1,325 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	accessSourceOrganization = "organization"
+	accessSourceWorkspace    = "workspace"
+)
+
+var _ datasource.DataSource = &WorkspaceAccessDataSource{}
+
+func NewWorkspaceAccessDataSource() datasource.DataSource {
+	return &WorkspaceAccessDataSource{}
+}
+
+// WorkspaceAccessDataSource resolves who can do what on a workspace. It
+// combines the organization-level and workspace-level grants of users and
+// teams, expanding team grants to the team members.
+type WorkspaceAccessDataSource struct {
+	provider *InfradotsProvider
+}
+
+type WorkspaceAccessDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationName types.String `tfsdk:"organization_name"`
+	WorkspaceName    types.String `tfsdk:"workspace_name"`
+	Permission       types.String `tfsdk:"permission"`
+	Users            types.List   `tfsdk:"users"`
+}
+
+type WorkspaceAccessUserModel struct {
+	Email       types.String `tfsdk:"email"`
+	Permissions types.List   `tfsdk:"permissions"`
+	Grants      types.List   `tfsdk:"grants"`
+}
+
+type WorkspaceAccessGrantModel struct {
+	Permission types.String `tfsdk:"permission"`
+	Source     types.String `tfsdk:"source"`
+	TeamID     types.String `tfsdk:"team_id"`
+	TeamName   types.String `tfsdk:"team_name"`
+}
+
+var workspaceAccessGrantAttrTypes = map[string]attr.Type{
+	"permission": types.StringType,
+	"source":     types.StringType,
+	"team_id":    types.StringType,
+	"team_name":  types.StringType,
+}
+
+var workspaceAccessUserAttrTypes = map[string]attr.Type{
+	"email":       types.StringType,
+	"permissions": types.ListType{ElemType: types.StringType},
+	"grants":      types.ListType{ElemType: types.ObjectType{AttrTypes: workspaceAccessGrantAttrTypes}},
+}
+
+// workspaceAccessGrant is one way a user obtained a permission on the
+// workspace. TeamID and TeamName are empty for grants made to the user.
+type workspaceAccessGrant struct {
+	Permission string
+	Source     string
+	TeamID     string
+	TeamName   string
+}
+
+type workspaceAccessUser struct {
+	Email       string
+	Permissions []string
+	Grants      []workspaceAccessGrant
+}
+
+func (d *WorkspaceAccessDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_access_data"
+}
+
+func (d *WorkspaceAccessDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resolves the effective permissions of every user on a workspace, including those inherited from team membership and organization-level grants.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Composite ID (organization_name:workspace_name).",
+				Computed:    true,
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization.",
+				Required:    true,
+			},
+			"workspace_name": schema.StringAttribute{
+				Description: "The name of the workspace.",
+				Required:    true,
+			},
+			"permission": schema.StringAttribute{
+				Description: "Only return users holding this permission codename.",
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "Users with at least one permission on the workspace, sorted by email.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Description: "The email of the user.",
+							Computed:    true,
+						},
+						"permissions": schema.ListAttribute{
+							Description: "The sorted effective permission codenames of the user on the workspace.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"grants": schema.ListNestedAttribute{
+							Description: "Where each permission comes from.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"permission": schema.StringAttribute{
+										Description: "The permission codename.",
+										Computed:    true,
+									},
+									"source": schema.StringAttribute{
+										Description: "organization if the permission was granted for the whole organization, workspace if it was granted on this workspace.",
+										Computed:    true,
+									},
+									"team_id": schema.StringAttribute{
+										Description: "The team the permission is inherited from; empty if it was granted to the user.",
+										Computed:    true,
+									},
+									"team_name": schema.StringAttribute{
+										Description: "The name of the team the permission is inherited from; empty if it was granted to the user.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WorkspaceAccessDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	provider, ok := req.ProviderData.(*InfradotsProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *InfradotsProvider, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.provider = provider
+}
+
+func (d *WorkspaceAccessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkspaceAccessDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgName := data.OrganizationName.ValueString()
+	wsName := data.WorkspaceName.ValueString()
+
+	orgGrants, err := listPermissionGrants(d.provider, orgName, "")
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization permissions", err.Error())
+		return
+	}
+	wsGrants, err := listPermissionGrants(d.provider, orgName, wsName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workspace permissions", err.Error())
+		return
+	}
+	teams, err := listTeams(d.provider, orgName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading teams", err.Error())
+		return
+	}
+
+	users := effectiveWorkspaceAccess(orgGrants, wsGrants, teams, wsName)
+	if filter := data.Permission.ValueString(); filter != "" {
+		users = filterWorkspaceAccess(users, filter)
+	}
+
+	userModels := make([]WorkspaceAccessUserModel, 0, len(users))
+	for _, u := range users {
+		grantModels := make([]WorkspaceAccessGrantModel, 0, len(u.Grants))
+		for _, g := range u.Grants {
+			grantModels = append(grantModels, WorkspaceAccessGrantModel{
+				Permission: types.StringValue(g.Permission),
+				Source:     types.StringValue(g.Source),
+				TeamID:     types.StringValue(g.TeamID),
+				TeamName:   types.StringValue(g.TeamName),
+			})
+		}
+		grants, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workspaceAccessGrantAttrTypes}, grantModels)
+		resp.Diagnostics.Append(diags...)
+		perms, diags := types.ListValueFrom(ctx, types.StringType, u.Permissions)
+		resp.Diagnostics.Append(diags...)
+
+		userModels = append(userModels, WorkspaceAccessUserModel{
+			Email:       types.StringValue(u.Email),
+			Permissions: perms,
+			Grants:      grants,
+		})
+	}
+	usersValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workspaceAccessUserAttrTypes}, userModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Users = usersValue
+	data.ID = types.StringValue(orgName + ":" + wsName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// effectiveWorkspaceAccess merges the grants that apply to workspace into
+// one entry per user. Organization-level codenames that do not apply to
+// workspaces (e.g. write_teams) are ignored. Team grants are matched to teams
+// by ID or name and expanded to every member of the team.
+func effectiveWorkspaceAccess(orgGrants, wsGrants []PermissionAPIResponse, teams []TeamAPIResponse, workspace string) []workspaceAccessUser {
+	teamsByRef := map[string]TeamAPIResponse{}
+	for _, t := range teams {
+		teamsByRef[t.ID] = t
+		teamsByRef[t.Name] = t
+	}
+
+	// Emails are matched regardless of case; the first casing seen is the one
+	// reported.
+	byEmail := map[string]*workspaceAccessUser{}
+	seen := map[string]bool{}
+	add := func(email string, g workspaceAccessGrant) {
+		lower := strings.ToLower(email)
+		key := lower + "\x00" + g.Permission + "\x00" + g.Source + "\x00" + g.TeamID
+		if email == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+		u, ok := byEmail[lower]
+		if !ok {
+			u = &workspaceAccessUser{Email: email}
+			byEmail[lower] = u
+		}
+		u.Grants = append(u.Grants, g)
+		u.Permissions = addToSet(u.Permissions, g.Permission)
+	}
+
+	collect := func(grants []PermissionAPIResponse, source string) {
+		for _, p := range grants {
+			if permissionCodenames[p.Permission] == permissionLevelOrganization {
+				continue
+			}
+			if source == accessSourceOrganization && p.Workspace != "" {
+				continue
+			}
+			if source == accessSourceWorkspace && p.Workspace != "" && p.Workspace != workspace {
+				continue
+			}
+			if p.User != "" {
+				add(p.User, workspaceAccessGrant{Permission: p.Permission, Source: source})
+			}
+			if p.Team != "" {
+				team, ok := teamsByRef[p.Team]
+				if !ok {
+					continue
+				}
+				for _, m := range team.Members {
+					add(m["email"], workspaceAccessGrant{
+						Permission: p.Permission,
+						Source:     source,
+						TeamID:     team.ID,
+						TeamName:   team.Name,
+					})
+				}
+			}
+		}
+	}
+	collect(orgGrants, accessSourceOrganization)
+	collect(wsGrants, accessSourceWorkspace)
+
+	users := make([]workspaceAccessUser, 0, len(byEmail))
+	for _, u := range byEmail {
+		sort.Strings(u.Permissions)
+		sort.SliceStable(u.Grants, func(i, j int) bool {
+			a, b := u.Grants[i], u.Grants[j]
+			if a.Permission != b.Permission {
+				return a.Permission < b.Permission
+			}
+			if a.Source != b.Source {
+				return a.Source < b.Source
+			}
+			return a.TeamName < b.TeamName
+		})
+		users = append(users, *u)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Email < users[j].Email })
+	return users
+}
+
+// filterWorkspaceAccess keeps the users holding permission.
+func filterWorkspaceAccess(users []workspaceAccessUser, permission string) []workspaceAccessUser {
+	filtered := []workspaceAccessUser{}
+	for _, u := range users {
+		for _, p := range u.Permissions {
+			if p == permission {
+				filtered = append(filtered, u)
+				break
+			}
+		}
+	}
+	return filtered
+}
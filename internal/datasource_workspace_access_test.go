@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWorkspaceAccessRoundTripper serves the permissions and teams of
+// test-org. alice is a member of devops, which can write every workspace;
+// bob reads prod directly and carol only holds organization permissions.
+type MockWorkspaceAccessRoundTripper struct{}
+
+func (m *MockWorkspaceAccessRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusOK,
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	var body string
+	switch {
+	case req.Method == http.MethodGet && req.URL.Path == "/api/permissions/test-org/workspaces/":
+		body = `[
+			{"permission": "read_workspaces", "user": "bob@example.com", "organization": "test-org", "workspace": "prod"},
+			{"permission": "read_workspaces", "team": "devops", "organization": "test-org", "workspace": "prod"}
+		]`
+	case req.Method == http.MethodGet && req.URL.Path == "/api/permissions/test-org/":
+		body = `[
+			{"permission": "write_workspaces", "team": "team-1", "organization": "test-org"},
+			{"permission": "write_teams", "user": "carol@example.com", "organization": "test-org"},
+			{"permission": "write_workspaces", "user": "dave@example.com", "organization": "test-org", "workspace": "staging"}
+		]`
+	case req.Method == http.MethodGet && req.URL.Path == "/api/organizations/test-org/teams/":
+		body = `[{
+			"id": "team-1",
+			"name": "devops",
+			"members": [{"email": "alice@example.com", "role": "member"}],
+			"permissions": []
+		}]`
+	default:
+		resp.StatusCode = http.StatusNotFound
+		body = `{"error": "Not found"}`
+	}
+	resp.Body = io.NopCloser(strings.NewReader(body))
+	return resp, nil
+}
+
+func readWorkspaceAccessDataSource(t *testing.T, config WorkspaceAccessDataSourceModel) (WorkspaceAccessDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	d := &WorkspaceAccessDataSource{provider: &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: &MockWorkspaceAccessRoundTripper{}},
+	}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	config.Users = types.ListNull(types.ObjectType{AttrTypes: workspaceAccessUserAttrTypes})
+	stateForConfig := tfsdk.State{Schema: schemaResp.Schema}
+	require.Empty(t, stateForConfig.Set(ctx, &config))
+
+	response := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: stateForConfig.Raw},
+	}, response)
+
+	var out WorkspaceAccessDataSourceModel
+	if !response.Diagnostics.HasError() {
+		require.Empty(t, response.State.Get(ctx, &out))
+	}
+	return out, response
+}
+
+func TestWorkspaceAccessDataSource_Metadata(t *testing.T) {
+	d := NewWorkspaceAccessDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "infradots"}, resp)
+	assert.Equal(t, "infradots_workspace_access_data", resp.TypeName)
+}
+
+func TestWorkspaceAccessDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	out, response := readWorkspaceAccessDataSource(t, WorkspaceAccessDataSourceModel{
+		OrganizationName: types.StringValue("test-org"),
+		WorkspaceName:    types.StringValue("prod"),
+		Permission:       types.StringNull(),
+	})
+	require.False(t, response.Diagnostics.HasError(), "%v", response.Diagnostics)
+	assert.Equal(t, "test-org:prod", out.ID.ValueString())
+
+	var users []WorkspaceAccessUserModel
+	require.Empty(t, out.Users.ElementsAs(ctx, &users, false))
+	// carol only holds an organization-level codename and dave's grant is on
+	// another workspace, so neither has access to prod.
+	require.Len(t, users, 2)
+
+	assert.Equal(t, "alice@example.com", users[0].Email.ValueString())
+	var perms []string
+	require.Empty(t, users[0].Permissions.ElementsAs(ctx, &perms, false))
+	assert.Equal(t, []string{"read_workspaces", "write_workspaces"}, perms)
+
+	var grants []WorkspaceAccessGrantModel
+	require.Empty(t, users[0].Grants.ElementsAs(ctx, &grants, false))
+	require.Len(t, grants, 2)
+	assert.Equal(t, "read_workspaces", grants[0].Permission.ValueString())
+	assert.Equal(t, accessSourceWorkspace, grants[0].Source.ValueString())
+	assert.Equal(t, "team-1", grants[0].TeamID.ValueString())
+	assert.Equal(t, "write_workspaces", grants[1].Permission.ValueString())
+	assert.Equal(t, accessSourceOrganization, grants[1].Source.ValueString())
+	assert.Equal(t, "devops", grants[1].TeamName.ValueString())
+
+	assert.Equal(t, "bob@example.com", users[1].Email.ValueString())
+	require.Empty(t, users[1].Grants.ElementsAs(ctx, &grants, false))
+	require.Len(t, grants, 1)
+	assert.Equal(t, "", grants[0].TeamID.ValueString())
+}
+
+func TestWorkspaceAccessDataSource_ReadPermissionFilter(t *testing.T) {
+	ctx := context.Background()
+	out, response := readWorkspaceAccessDataSource(t, WorkspaceAccessDataSourceModel{
+		OrganizationName: types.StringValue("test-org"),
+		WorkspaceName:    types.StringValue("prod"),
+		Permission:       types.StringValue("write_workspaces"),
+	})
+	require.False(t, response.Diagnostics.HasError(), "%v", response.Diagnostics)
+
+	var users []WorkspaceAccessUserModel
+	require.Empty(t, out.Users.ElementsAs(ctx, &users, false))
+	require.Len(t, users, 1)
+	assert.Equal(t, "alice@example.com", users[0].Email.ValueString())
+}
+
+func TestEffectiveWorkspaceAccess_EmailCase(t *testing.T) {
+	users := effectiveWorkspaceAccess(
+		[]PermissionAPIResponse{{User: "Erin@Example.com", Permission: "read_workspaces"}},
+		[]PermissionAPIResponse{{User: "erin@example.com", Permission: "write_workspaces", Workspace: "prod"}},
+		nil, "prod")
+	require.Len(t, users, 1)
+	assert.Equal(t, "Erin@Example.com", users[0].Email)
+	assert.Equal(t, []string{"read_workspaces", "write_workspaces"}, users[0].Permissions)
+}
@@ -29,6 +29,25 @@ type WorkspaceScheduleDataSourceModel struct {
 	Type             types.String `tfsdk:"type"`
 	Crontab          types.String `tfsdk:"crontab"`
 	Schedule         types.String `tfsdk:"schedule"`
+	Timezone         types.String `tfsdk:"timezone"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	StartDate        types.String `tfsdk:"start_date"`
+	EndDate          types.String `tfsdk:"end_date"`
+}
+
+// setFromAPI copies a schedule API response into the data source model.
+func (m *WorkspaceScheduleDataSourceModel) setFromAPI(s WorkspaceScheduleAPIResponse) {
+	m.ID = types.StringValue(s.ID)
+	m.Type = types.StringValue(s.Type)
+	m.Crontab = types.StringValue(s.Crontab)
+	m.Schedule = types.StringValue(s.Schedule)
+	m.Timezone = types.StringValue("UTC")
+	if s.Timezone != "" {
+		m.Timezone = types.StringValue(s.Timezone)
+	}
+	m.Enabled = types.BoolValue(s.Enabled == nil || *s.Enabled)
+	m.StartDate = types.StringPointerValue(s.StartDate)
+	m.EndDate = types.StringPointerValue(s.EndDate)
 }
 
 func (d *WorkspaceScheduleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -65,6 +84,22 @@ func (d *WorkspaceScheduleDataSource) Schema(_ context.Context, _ datasource.Sch
 				Description: "A human-readable description of the schedule.",
 				Computed:    true,
 			},
+			"timezone": schema.StringAttribute{
+				Description: "The IANA time zone the crontab is evaluated in.",
+				Computed:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the schedule is active.",
+				Computed:    true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "RFC 3339 timestamp before which the schedule does not fire.",
+				Computed:    true,
+			},
+			"end_date": schema.StringAttribute{
+				Description: "RFC 3339 timestamp after which the schedule no longer fires.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -144,10 +179,7 @@ func (d *WorkspaceScheduleDataSource) Read(ctx context.Context, req datasource.R
 			return
 		}
 
-		data.ID = types.StringValue(apiResp.ID)
-		data.Type = types.StringValue(apiResp.Type)
-		data.Crontab = types.StringValue(apiResp.Crontab)
-		data.Schedule = types.StringValue(apiResp.Schedule)
+		data.setFromAPI(apiResp)
 	} else {
 		// Fetch list and filter by type
 		url := fmt.Sprintf("https://%s/api/organizations/%s/workspaces/%s/schedules/",
@@ -191,10 +223,7 @@ func (d *WorkspaceScheduleDataSource) Read(ctx context.Context, req datasource.R
 		found := false
 		for _, s := range apiRespList {
 			if s.Type == filterType {
-				data.ID = types.StringValue(s.ID)
-				data.Type = types.StringValue(s.Type)
-				data.Crontab = types.StringValue(s.Crontab)
-				data.Schedule = types.StringValue(s.Schedule)
+				data.setFromAPI(s)
 				found = true
 				break
 			}
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// optionalString keeps an unset optional attribute null when the API reports
+// it as empty.
+func optionalString(current types.String, value string) types.String {
+	if value == "" && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// optionalStringPointer maps a nullable API value; a missing or empty value
+// is null, as it is when the attribute is not configured.
+func optionalStringPointer(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+// optionalInt64 maps a numeric setting reported by the API. Zero means the
+// setting is not set, which is null as when the attribute is not configured.
+func optionalInt64(value int64) types.Int64 {
+	if value == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value)
+}
+
+// clearableString returns the value to send in a partial update; an empty
+// string clears the setting when it was removed from the configuration.
+func clearableString(v types.String) *string {
+	s := v.ValueString()
+	return &s
+}
+
+// nullableString returns the JSON value to send in a partial update for a
+// setting the API clears with null rather than an empty string, such as a
+// reference to another object.
+func nullableString(v types.String) json.RawMessage {
+	if v.IsNull() {
+		return json.RawMessage("null")
+	}
+	// Marshaling a string cannot fail.
+	value, _ := json.Marshal(v.ValueString())
+	return value
+}
+
+// clearableInt64 is clearableString for numeric settings, which are cleared
+// with 0.
+func clearableInt64(v types.Int64) *int64 {
+	n := v.ValueInt64()
+	return &n
+}
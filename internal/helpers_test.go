@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestResource runs Create with plan, which also serves as the
+// configuration, and decodes the resulting state into out. The state is
+// decoded even when Create reports an error, so tests can check what is kept
+// for Terraform to taint.
+func createTestResource(t *testing.T, r resource.Resource, plan interface{}, out interface{}) *resource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	request := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, request.Plan.Set(ctx, plan))
+	request.Config = tfsdk.Config{Schema: schemaResp.Schema, Raw: request.Plan.Raw}
+
+	response := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, request, response)
+	if !response.State.Raw.IsNull() {
+		require.Empty(t, response.State.Get(ctx, out))
+	}
+	return response
+}
+
+func readTestDataSource(t *testing.T, d datasource.DataSource, config interface{}, out interface{}) *datasource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	stateForConfig := tfsdk.State{Schema: schemaResp.Schema}
+	require.Empty(t, stateForConfig.Set(ctx, config))
+
+	response := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: stateForConfig.Raw},
+	}, response)
+	if !response.Diagnostics.HasError() {
+		require.Empty(t, response.State.Get(ctx, out))
+	}
+	return response
+}
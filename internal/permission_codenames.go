@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+)
+
+// permissionLevel tells at which level a permission codename can be granted.
+// The API has no codename that can only be granted on a workspace.
+type permissionLevel int
+
+const (
+	// permissionLevelAny codenames can be granted for the organization, where
+	// they apply to every workspace, or for a single workspace.
+	permissionLevelAny permissionLevel = iota
+	permissionLevelOrganization
+)
+
+// permissionCodenames lists the codenames accepted by the permissions API.
+var permissionCodenames = map[string]permissionLevel{
+	"read_organizations":  permissionLevelOrganization,
+	"write_organizations": permissionLevelOrganization,
+	"read_teams":          permissionLevelOrganization,
+	"write_teams":         permissionLevelOrganization,
+	"read_workspaces":     permissionLevelAny,
+	"write_workspaces":    permissionLevelAny,
+}
+
+// knownPermissionCodenames returns the sorted codenames for use in validators.
+func knownPermissionCodenames() []string {
+	names := make([]string, 0, len(permissionCodenames))
+	for name := range permissionCodenames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// permissionScopeError describes why codename cannot be granted at the given
+// scope, or returns "" if it can. Unknown codenames are left to the OneOf
+// validators.
+func permissionScopeError(codename string, workspaceScoped bool) string {
+	level, ok := permissionCodenames[codename]
+	if !ok {
+		return ""
+	}
+	if level == permissionLevelOrganization && workspaceScoped {
+		return fmt.Sprintf("%q is an organization-level permission and cannot be granted on a workspace.", codename)
+	}
+	return ""
+}
@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKnownPermissionCodenames(t *testing.T) {
+	names := knownPermissionCodenames()
+	assert.Len(t, names, len(permissionCodenames))
+	assert.IsNonDecreasing(t, names)
+	assert.Contains(t, names, "read_workspaces")
+}
+
+func TestPermissionScopeError(t *testing.T) {
+	tests := []struct {
+		codename        string
+		workspaceScoped bool
+		wantError       bool
+	}{
+		{"read_workspaces", false, false},
+		{"read_workspaces", true, false},
+		{"write_teams", false, false},
+		{"write_teams", true, true},
+		{"read_organizations", true, true},
+		{"write_workspaces", false, false},
+		// Unknown codenames are reported by the OneOf validators.
+		{"admin", true, false},
+	}
+
+	for _, tt := range tests {
+		msg := permissionScopeError(tt.codename, tt.workspaceScoped)
+		assert.Equal(t, tt.wantError, msg != "", "%s (workspace=%v): %q", tt.codename, tt.workspaceScoped, msg)
+	}
+}
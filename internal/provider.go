@@ -116,6 +116,14 @@ func (p *InfradotsProvider) Resources(_ context.Context) []func() resource.Resou
 		NewModelProviderResource,
 		NewWorkspaceScheduleResource,
 		NewAgentSkillResource,
+		NewDriftPolicyResource,
+		NewTeamMembershipResource,
+		NewSSOConfigurationResource,
+		NewTeamSSOMappingResource,
+		NewRoleResource,
+		NewRoleAssignmentResource,
+		NewPermissionsResource,
+		NewSSHKeyResource,
 	}
 }
 
@@ -134,5 +142,12 @@ func (p *InfradotsProvider) DataSources(_ context.Context) []func() datasource.D
 		NewModelProviderDataSource,
 		NewIntegrationDataSource,
 		NewWorkspaceScheduleDataSource,
+		NewWorkspaceAccessDataSource,
+		NewServiceAccountsDataSource,
+		NewServiceAccountTokensDataSource,
+		NewSSHKeyDataSource,
+		NewVCSStatusDataSource,
+		NewVCSRepositoriesDataSource,
+		NewVCSBranchesDataSource,
 	}
 }
@@ -0,0 +1,408 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &DriftPolicyResource{}
+	_ resource.ResourceWithImportState = &DriftPolicyResource{}
+)
+
+func NewDriftPolicyResource() resource.Resource {
+	return &DriftPolicyResource{}
+}
+
+type DriftPolicyResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	OrganizationName           types.String `tfsdk:"organization_name"`
+	WorkspaceName              types.String `tfsdk:"workspace_name"`
+	Enabled                    types.Bool   `tfsdk:"enabled"`
+	CheckIntervalHours         types.Int64  `tfsdk:"check_interval_hours"`
+	RemediationAction          types.String `tfsdk:"remediation_action"`
+	NotificationIntegrationIDs types.List   `tfsdk:"notification_integration_ids"`
+	ExclusionPatterns          types.List   `tfsdk:"exclusion_patterns"`
+}
+
+type DriftPolicyAPIResponse struct {
+	ID                         string   `json:"id"`
+	Workspace                  string   `json:"workspace"`
+	Enabled                    bool     `json:"enabled"`
+	CheckIntervalHours         int64    `json:"check_interval_hours"`
+	RemediationAction          string   `json:"remediation_action"`
+	NotificationIntegrationIDs []string `json:"notification_integrations"`
+	ExclusionPatterns          []string `json:"exclusion_patterns"`
+}
+
+// DriftPolicyRequest is sent with PUT for both create and update: a scope has
+// at most one drift policy, so the full desired state is always submitted.
+type DriftPolicyRequest struct {
+	Enabled                    bool     `json:"enabled"`
+	CheckIntervalHours         int64    `json:"check_interval_hours"`
+	RemediationAction          string   `json:"remediation_action"`
+	NotificationIntegrationIDs []string `json:"notification_integrations"`
+	ExclusionPatterns          []string `json:"exclusion_patterns"`
+}
+
+type DriftPolicyResource struct {
+	provider *InfradotsProvider
+}
+
+func (r *DriftPolicyResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "infradots_drift_policy"
+}
+
+func (r *DriftPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Drift detection policy for an organization, or an override for a single workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The drift policy unique ID (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization this policy belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_name": schema.StringAttribute{
+				Description: "The name of the workspace this policy overrides. When omitted, the policy is the organization default.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether drift detection runs for this scope. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"check_interval_hours": schema.Int64Attribute{
+				Description: "How often to check for drift, in hours (1-168). Defaults to 24.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(24),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 168),
+				},
+			},
+			"remediation_action": schema.StringAttribute{
+				Description: "What to do when drift is detected. One of: none, notify, plan, apply. Defaults to notify.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("notify"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "notify", "plan", "apply"),
+				},
+			},
+			"notification_integration_ids": schema.ListAttribute{
+				Description: "IDs of integrations notified when drift is detected.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"exclusion_patterns": schema.ListAttribute{
+				Description: "Resource address patterns ignored by drift detection (e.g. 'aws_autoscaling_group.*').",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+func (r *DriftPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		if provider, ok := req.ProviderData.(*InfradotsProvider); ok {
+			r.provider = provider
+		}
+	}
+}
+
+// policyURL returns the organization-level policy URL, or the workspace
+// override URL when workspaceName is set.
+func (r *DriftPolicyResource) policyURL(orgName, workspaceName string) string {
+	if workspaceName == "" {
+		return fmt.Sprintf("https://%s/api/organizations/%s/drift-policy/", r.provider.host, orgName)
+	}
+	return fmt.Sprintf("https://%s/api/organizations/%s/workspaces/%s/drift-policy/", r.provider.host, orgName, workspaceName)
+}
+
+// stringListOrNull converts API list values to state, keeping an unset
+// optional list null when the API reports it as empty.
+func stringListOrNull(ctx context.Context, current types.List, values []string) (types.List, diag.Diagnostics) {
+	if len(values) == 0 && current.IsNull() {
+		return types.ListNull(types.StringType), nil
+	}
+	if values == nil {
+		values = []string{}
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+func driftPolicyAPIToModel(ctx context.Context, data *DriftPolicyResourceModel, policy DriftPolicyAPIResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(policy.ID)
+	data.Enabled = types.BoolValue(policy.Enabled)
+	data.CheckIntervalHours = types.Int64Value(policy.CheckIntervalHours)
+	data.RemediationAction = types.StringValue(policy.RemediationAction)
+
+	var d diag.Diagnostics
+	data.NotificationIntegrationIDs, d = stringListOrNull(ctx, data.NotificationIntegrationIDs, policy.NotificationIntegrationIDs)
+	diags.Append(d...)
+	data.ExclusionPatterns, d = stringListOrNull(ctx, data.ExclusionPatterns, policy.ExclusionPatterns)
+	diags.Append(d...)
+
+	return diags
+}
+
+// put submits the desired policy and returns the stored one.
+func (r *DriftPolicyResource) put(ctx context.Context, data *DriftPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	body := DriftPolicyRequest{
+		Enabled:                    data.Enabled.ValueBool(),
+		CheckIntervalHours:         data.CheckIntervalHours.ValueInt64(),
+		RemediationAction:          data.RemediationAction.ValueString(),
+		NotificationIntegrationIDs: []string{},
+		ExclusionPatterns:          []string{},
+	}
+	if !data.NotificationIntegrationIDs.IsNull() {
+		diags.Append(data.NotificationIntegrationIDs.ElementsAs(ctx, &body.NotificationIntegrationIDs, false)...)
+	}
+	if !data.ExclusionPatterns.IsNull() {
+		diags.Append(data.ExclusionPatterns.ElementsAs(ctx, &body.ExclusionPatterns, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		diags.AddError("Error marshaling request", err.Error())
+		return diags
+	}
+
+	url := r.policyURL(data.OrganizationName.ValueString(), data.WorkspaceName.ValueString())
+	httpReq, err := http.NewRequest(http.MethodPut, url, strings.NewReader(string(reqBody)))
+	if err != nil {
+		diags.AddError("Error creating request", err.Error())
+		return diags
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+r.provider.token)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := r.provider.client.Do(httpReq)
+	if err != nil {
+		diags.AddError("HTTP request failed", err.Error())
+		return diags
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Error reading response body", err.Error())
+		return diags
+	}
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
+		diags.AddError(
+			"Failed to save drift policy",
+			fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)),
+		)
+		return diags
+	}
+
+	var policy DriftPolicyAPIResponse
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		diags.AddError("Error parsing response", err.Error())
+		return diags
+	}
+
+	diags.Append(driftPolicyAPIToModel(ctx, data, policy)...)
+	return diags
+}
+
+// get fetches the policy for the model's scope. found is false on 404.
+func (r *DriftPolicyResource) get(ctx context.Context, data *DriftPolicyResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	url := r.policyURL(data.OrganizationName.ValueString(), data.WorkspaceName.ValueString())
+	httpReq, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		diags.AddError("Error creating request", err.Error())
+		return false, diags
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+r.provider.token)
+
+	httpResp, err := r.provider.client.Do(httpReq)
+	if err != nil {
+		diags.AddError("HTTP request failed", err.Error())
+		return false, diags
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == 404 {
+		return false, diags
+	}
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Error reading response body", err.Error())
+		return false, diags
+	}
+	if httpResp.StatusCode != 200 {
+		diags.AddError("Read failed", fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)))
+		return false, diags
+	}
+
+	var policy DriftPolicyAPIResponse
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		diags.AddError("Error parsing response", err.Error())
+		return false, diags
+	}
+
+	diags.Append(driftPolicyAPIToModel(ctx, data, policy)...)
+	return true, diags
+}
+
+func (r *DriftPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DriftPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.put(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DriftPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DriftPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DriftPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DriftPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.put(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DriftPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DriftPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := r.policyURL(data.OrganizationName.ValueString(), data.WorkspaceName.ValueString())
+	httpReq, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+r.provider.token)
+
+	httpResp, err := r.provider.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("HTTP request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 204 && httpResp.StatusCode != 200 && httpResp.StatusCode != 404 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		resp.Diagnostics.AddError("Delete failed", fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)))
+	}
+}
+
+func (r *DriftPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Format 1: "organization_name" for the organization policy
+	// Format 2: "organization_name:workspace_name" for a workspace override
+	parts := strings.Split(req.ID, ":")
+	if len(parts) > 2 || parts[0] == "" || (len(parts) == 2 && parts[1] == "") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Import ID must be in the format 'organization_name' for the organization policy or 'organization_name:workspace_name' for a workspace override",
+		)
+		return
+	}
+
+	var data DriftPolicyResourceModel
+	data.OrganizationName = types.StringValue(parts[0])
+	data.WorkspaceName = types.StringNull()
+	if len(parts) == 2 {
+		data.WorkspaceName = types.StringValue(parts[1])
+	}
+	data.NotificationIntegrationIDs = types.ListNull(types.StringType)
+	data.ExclusionPatterns = types.ListNull(types.StringType)
+
+	found, diags := r.get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Drift policy not found", fmt.Sprintf("No drift policy found for %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,244 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockDriftPolicyRoundTripper implements http.RoundTripper for testing the drift policy resource.
+// It records the last PUT body so tests can assert on what was sent.
+type MockDriftPolicyRoundTripper struct {
+	lastPut DriftPolicyRequest
+}
+
+func (m *MockDriftPolicyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusOK,
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	url := req.URL.String()
+
+	// Organization-level policy
+	if strings.HasSuffix(url, "/api/organizations/test-org/drift-policy/") {
+		switch req.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &m.lastPut)
+			out, _ := json.Marshal(DriftPolicyAPIResponse{
+				ID:                         "d1f7a0c2-0000-4000-8000-000000000001",
+				Enabled:                    m.lastPut.Enabled,
+				CheckIntervalHours:         m.lastPut.CheckIntervalHours,
+				RemediationAction:          m.lastPut.RemediationAction,
+				NotificationIntegrationIDs: m.lastPut.NotificationIntegrationIDs,
+				ExclusionPatterns:          m.lastPut.ExclusionPatterns,
+			})
+			resp.Body = io.NopCloser(strings.NewReader(string(out)))
+			return resp, nil
+		case http.MethodGet:
+			jsonResp := `{
+				"id": "d1f7a0c2-0000-4000-8000-000000000001",
+				"workspace": "",
+				"enabled": true,
+				"check_interval_hours": 12,
+				"remediation_action": "plan",
+				"notification_integrations": ["int-1"],
+				"exclusion_patterns": []
+			}`
+			resp.Body = io.NopCloser(strings.NewReader(jsonResp))
+			return resp, nil
+		case http.MethodDelete:
+			resp.StatusCode = http.StatusNoContent
+			resp.Body = io.NopCloser(strings.NewReader(""))
+			return resp, nil
+		}
+	}
+
+	// Workspace override
+	if req.Method == http.MethodGet && strings.HasSuffix(url, "/api/organizations/test-org/workspaces/prod/drift-policy/") {
+		jsonResp := `{
+			"id": "d1f7a0c2-0000-4000-8000-000000000002",
+			"workspace": "prod",
+			"enabled": true,
+			"check_interval_hours": 1,
+			"remediation_action": "notify",
+			"notification_integrations": [],
+			"exclusion_patterns": ["aws_autoscaling_group.*"]
+		}`
+		resp.Body = io.NopCloser(strings.NewReader(jsonResp))
+		return resp, nil
+	}
+
+	// Default: 404
+	resp.StatusCode = http.StatusNotFound
+	resp.Body = io.NopCloser(strings.NewReader(`{"error": "Not found"}`))
+	return resp, nil
+}
+
+func setupTestDriftPolicyResource(t *testing.T) (*DriftPolicyResource, *MockDriftPolicyRoundTripper) {
+	t.Helper()
+
+	transport := &MockDriftPolicyRoundTripper{}
+	provider := &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: transport},
+	}
+
+	return &DriftPolicyResource{provider: provider}, transport
+}
+
+func TestDriftPolicyResource_Metadata(t *testing.T) {
+	r := NewDriftPolicyResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{}, resp)
+	assert.Equal(t, "infradots_drift_policy", resp.TypeName)
+}
+
+func TestDriftPolicyResource_Schema(t *testing.T) {
+	r := NewDriftPolicyResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+	attrs := resp.Schema.Attributes
+	for _, name := range []string{
+		"id", "organization_name", "workspace_name", "enabled", "check_interval_hours",
+		"remediation_action", "notification_integration_ids", "exclusion_patterns",
+	} {
+		assert.Contains(t, attrs, name)
+	}
+
+	assert.True(t, attrs["organization_name"].(schema.StringAttribute).Required)
+	assert.True(t, attrs["workspace_name"].(schema.StringAttribute).Optional)
+	assert.True(t, attrs["check_interval_hours"].(schema.Int64Attribute).Computed)
+	assert.True(t, attrs["notification_integration_ids"].(schema.ListAttribute).Optional)
+}
+
+func TestDriftPolicyResource_Create(t *testing.T) {
+	r, transport := setupTestDriftPolicyResource(t)
+	ctx := context.Background()
+
+	var plan DriftPolicyResourceModel
+	plan.OrganizationName = types.StringValue("test-org")
+	plan.WorkspaceName = types.StringNull()
+	plan.Enabled = types.BoolValue(true)
+	plan.CheckIntervalHours = types.Int64Value(12)
+	plan.RemediationAction = types.StringValue("plan")
+	plan.NotificationIntegrationIDs = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("int-1")})
+	plan.ExclusionPatterns = types.ListNull(types.StringType)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	request := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, request.Plan.Set(ctx, &plan))
+	response := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	r.Create(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+	assert.Equal(t, int64(12), transport.lastPut.CheckIntervalHours)
+	assert.Equal(t, []string{"int-1"}, transport.lastPut.NotificationIntegrationIDs)
+	assert.Equal(t, []string{}, transport.lastPut.ExclusionPatterns)
+
+	var state DriftPolicyResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "d1f7a0c2-0000-4000-8000-000000000001", state.ID.ValueString())
+	assert.Equal(t, "plan", state.RemediationAction.ValueString())
+	assert.Len(t, state.NotificationIntegrationIDs.Elements(), 1)
+	// Unset optional lists stay null even though the API returns [].
+	assert.True(t, state.ExclusionPatterns.IsNull())
+}
+
+func TestDriftPolicyResource_Delete(t *testing.T) {
+	r, _ := setupTestDriftPolicyResource(t)
+	ctx := context.Background()
+
+	var state DriftPolicyResourceModel
+	state.ID = types.StringValue("d1f7a0c2-0000-4000-8000-000000000001")
+	state.OrganizationName = types.StringValue("test-org")
+	state.WorkspaceName = types.StringNull()
+	state.Enabled = types.BoolValue(true)
+	state.CheckIntervalHours = types.Int64Value(24)
+	state.RemediationAction = types.StringValue("notify")
+	state.NotificationIntegrationIDs = types.ListNull(types.StringType)
+	state.ExclusionPatterns = types.ListNull(types.StringType)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	request := resource.DeleteRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+	require.Empty(t, request.State.Set(ctx, &state))
+	response := resource.DeleteResponse{State: request.State}
+
+	r.Delete(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+}
+
+func TestDriftPolicyResource_ImportState(t *testing.T) {
+	r, _ := setupTestDriftPolicyResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	// Organization policy
+	response := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "test-org"}, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+	var state DriftPolicyResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.True(t, state.WorkspaceName.IsNull())
+	assert.Equal(t, int64(12), state.CheckIntervalHours.ValueInt64())
+	assert.True(t, state.ExclusionPatterns.IsNull())
+
+	// Workspace override
+	response = resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "test-org:prod"}, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "prod", state.WorkspaceName.ValueString())
+	assert.Equal(t, "d1f7a0c2-0000-4000-8000-000000000002", state.ID.ValueString())
+	assert.Len(t, state.ExclusionPatterns.Elements(), 1)
+}
+
+func TestDriftPolicyResource_ImportState_InvalidFormat(t *testing.T) {
+	r, _ := setupTestDriftPolicyResource(t)
+	ctx := context.Background()
+
+	for _, id := range []string{"", "a:b:c", "test-org:"} {
+		response := resource.ImportStateResponse{}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, &response)
+		require.True(t, response.Diagnostics.HasError(), id)
+		assert.Contains(t, response.Diagnostics.Errors()[0].Summary(), "Invalid import ID format")
+	}
+}
+
+func TestDriftPolicyResource_ImportState_NotFound(t *testing.T) {
+	r, _ := setupTestDriftPolicyResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	response := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "test-org:staging"}, &response)
+	require.True(t, response.Diagnostics.HasError())
+	assert.Contains(t, response.Diagnostics.Errors()[0].Summary(), "Drift policy not found")
+}
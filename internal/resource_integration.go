@@ -3,6 +3,7 @@ package internal
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -10,19 +11,36 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource                = &IntegrationResource{}
-	_ resource.ResourceWithImportState = &IntegrationResource{}
+	_ resource.Resource                   = &IntegrationResource{}
+	_ resource.ResourceWithImportState    = &IntegrationResource{}
+	_ resource.ResourceWithValidateConfig = &IntegrationResource{}
 )
 
+const (
+	integrationTypeWebhook   = "WEBHOOK"
+	integrationTypeCustom    = "CUSTOM"
+	integrationTypeSlack     = "SLACK"
+	integrationTypeTeams     = "TEAMS"
+	integrationTypePagerDuty = "PAGERDUTY"
+)
+
+// pagerDutySeverities are the event severities of the PagerDuty Events API.
+var pagerDutySeverities = []string{"critical", "error", "warning", "info"}
+
 func NewIntegrationResource() resource.Resource {
 	return &IntegrationResource{}
 }
@@ -37,31 +55,106 @@ type IntegrationResourceModel struct {
 	Description      types.String `tfsdk:"description"`
 	CreatedAt        types.String `tfsdk:"created_at"`
 	UpdatedAt        types.String `tfsdk:"updated_at"`
+	Teams            types.Object `tfsdk:"teams"`
+	PagerDuty        types.Object `tfsdk:"pagerduty"`
+	Webhook          types.Object `tfsdk:"webhook"`
+	VerifyOnCreate   types.Bool   `tfsdk:"verify_on_create"`
+}
+
+var integrationTeamsAttrTypes = map[string]attr.Type{
+	"webhook_url": types.StringType,
+}
+
+var integrationPagerDutyAttrTypes = map[string]attr.Type{
+	"routing_key":      types.StringType,
+	"default_severity": types.StringType,
+}
+
+var integrationWebhookAttrTypes = map[string]attr.Type{
+	"signing_secret":   types.StringType,
+	"headers":          types.MapType{ElemType: types.StringType},
+	"payload_template": types.StringType,
 }
 
 type IntegrationAPIResponse struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Type        string    `json:"type"`
-	APIURL      string    `json:"api_url"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string                      `json:"id"`
+	Name        string                      `json:"name"`
+	Type        string                      `json:"type"`
+	APIURL      string                      `json:"api_url"`
+	Description string                      `json:"description"`
+	CreatedAt   time.Time                   `json:"created_at"`
+	UpdatedAt   time.Time                   `json:"updated_at"`
+	Teams       *IntegrationTeamsConfig     `json:"teams,omitempty"`
+	PagerDuty   *IntegrationPagerDutyConfig `json:"pagerduty,omitempty"`
+	Webhook     *IntegrationWebhookConfig   `json:"webhook,omitempty"`
+}
+
+// IntegrationTeamsConfig configures a Microsoft Teams integration. The API
+// does not return the webhook URL.
+type IntegrationTeamsConfig struct {
+	WebhookURL string `json:"webhook_url,omitempty"`
+}
+
+// IntegrationPagerDutyConfig configures a PagerDuty integration. The API does
+// not return the routing key.
+type IntegrationPagerDutyConfig struct {
+	RoutingKey      string `json:"routing_key,omitempty"`
+	DefaultSeverity string `json:"default_severity,omitempty"`
+}
+
+// IntegrationWebhookConfig configures the deliveries of a WEBHOOK
+// integration. The API does not return the signing secret.
+type IntegrationWebhookConfig struct {
+	SigningSecret   string            `json:"signing_secret,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	PayloadTemplate string            `json:"payload_template,omitempty"`
+}
+
+// IntegrationTestAPIResponse is the result of a test delivery.
+type IntegrationTestAPIResponse struct {
+	Delivered bool   `json:"delivered"`
+	Error     string `json:"error"`
 }
 
 type IntegrationCreateRequest struct {
-	Name        string `json:"name"`
-	Type        string `json:"type"`
-	APIURL      string `json:"api_url,omitempty"`
-	APIKey      string `json:"api_key,omitempty"`
-	Description string `json:"description,omitempty"`
+	Name        string                      `json:"name"`
+	Type        string                      `json:"type"`
+	APIURL      string                      `json:"api_url,omitempty"`
+	APIKey      string                      `json:"api_key,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Teams       *IntegrationTeamsConfig     `json:"teams,omitempty"`
+	PagerDuty   *IntegrationPagerDutyConfig `json:"pagerduty,omitempty"`
+	Webhook     *IntegrationWebhookConfig   `json:"webhook,omitempty"`
 }
 
+// IntegrationUpdateRequest is a partial update. The configuration blocks are
+// only sent when they changed: whole, or as JSON null when removed.
 type IntegrationUpdateRequest struct {
-	Name        string `json:"name,omitempty"`
-	APIURL      string `json:"api_url,omitempty"`
-	APIKey      string `json:"api_key,omitempty"`
-	Description string `json:"description,omitempty"`
+	Name        string          `json:"name,omitempty"`
+	APIURL      string          `json:"api_url,omitempty"`
+	APIKey      string          `json:"api_key,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Teams       json.RawMessage `json:"teams,omitempty"`
+	PagerDuty   json.RawMessage `json:"pagerduty,omitempty"`
+	Webhook     json.RawMessage `json:"webhook,omitempty"`
+}
+
+// IntegrationTeamsUpdate, IntegrationPagerDutyUpdate and
+// IntegrationWebhookUpdate are the configuration blocks of an update. Every
+// setting is sent, as null when it was removed, so the platform clears it.
+type IntegrationTeamsUpdate struct {
+	WebhookURL *string `json:"webhook_url"`
+}
+
+type IntegrationPagerDutyUpdate struct {
+	RoutingKey      *string `json:"routing_key"`
+	DefaultSeverity *string `json:"default_severity"`
+}
+
+type IntegrationWebhookUpdate struct {
+	SigningSecret   *string           `json:"signing_secret"`
+	Headers         map[string]string `json:"headers"`
+	PayloadTemplate *string           `json:"payload_template"`
 }
 
 type IntegrationResource struct {
@@ -92,10 +185,10 @@ func (r *IntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Required:    true,
 			},
 			"type": schema.StringAttribute{
-				Description: "The type of integration. Valid values are: WEBHOOK, CUSTOM, SLACK.",
+				Description: "The type of integration. Valid values are: WEBHOOK, CUSTOM, SLACK, TEAMS, PAGERDUTY.",
 				Required:    true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("WEBHOOK", "CUSTOM", "SLACK"),
+					stringvalidator.OneOf(integrationTypeWebhook, integrationTypeCustom, integrationTypeSlack, integrationTypeTeams, integrationTypePagerDuty),
 				},
 			},
 			"api_url": schema.StringAttribute{
@@ -121,10 +214,141 @@ func (r *IntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "The timestamp when the integration was last updated.",
 				Computed:    true,
 			},
+			"verify_on_create": schema.BoolAttribute{
+				Description: "Send a test notification after creating the integration and fail the apply with the delivery error if it cannot be delivered. The integration is then tainted and replaced on the next apply. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"teams": schema.SingleNestedAttribute{
+				Description: "Microsoft Teams settings. Required for TEAMS integrations and not allowed otherwise.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"webhook_url": schema.StringAttribute{
+						Description: "The incoming webhook URL of the Teams channel. Write-only; not returned by the API on read.",
+						Required:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							httpURLValidator{},
+						},
+					},
+				},
+			},
+			"pagerduty": schema.SingleNestedAttribute{
+				Description: "PagerDuty settings. Required for PAGERDUTY integrations and not allowed otherwise.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"routing_key": schema.StringAttribute{
+						Description: "The integration (routing) key of the PagerDuty service. Write-only; not returned by the API on read.",
+						Required:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"default_severity": schema.StringAttribute{
+						Description: "The severity of events that do not map to one: critical, error, warning or info. Defaults to error.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("error"),
+						Validators: []validator.String{
+							stringvalidator.OneOf(pagerDutySeverities...),
+						},
+					},
+				},
+			},
+			"webhook": schema.SingleNestedAttribute{
+				Description: "Delivery settings for WEBHOOK integrations; not allowed for other types.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"signing_secret": schema.StringAttribute{
+						Description: "Secret used to sign each payload with HMAC-SHA256 so the receiver can verify it came from the platform. Write-only; not returned by the API on read.",
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(16),
+						},
+					},
+					"headers": schema.MapAttribute{
+						Description: "Additional HTTP headers sent with each delivery, e.g. an Authorization header expected by the receiver.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"payload_template": schema.StringAttribute{
+						Description: "Template of the request body. If not set, the platform's default JSON payload is sent.",
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
 
+// integrationTypeBlocks maps the integration types that need a typed
+// configuration block to that block. Blocks of other types must not be set.
+var integrationTypeBlocks = map[string]string{
+	integrationTypeTeams:     "teams",
+	integrationTypePagerDuty: "pagerduty",
+	integrationTypeWebhook:   "webhook",
+}
+
+// ValidateConfig checks that only the configuration block of the integration
+// type is set, and that TEAMS and PAGERDUTY integrations have theirs.
+func (r *IntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IntegrationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() {
+		return
+	}
+	integrationType := data.Type.ValueString()
+
+	isSet := map[string]bool{
+		"teams":     !data.Teams.IsNull(),
+		"pagerduty": !data.PagerDuty.IsNull(),
+		"webhook":   !data.Webhook.IsNull(),
+	}
+	for blockType, block := range integrationTypeBlocks {
+		if isSet[block] && blockType != integrationType {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(block),
+				"Invalid attribute for integration type",
+				fmt.Sprintf("%s only applies when type is %q.", block, blockType),
+			)
+		}
+	}
+
+	switch integrationType {
+	case integrationTypeTeams, integrationTypePagerDuty:
+		block := integrationTypeBlocks[integrationType]
+		if !isSet[block] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(block),
+				"Missing required attribute",
+				fmt.Sprintf("%s is required when type is %q.", block, integrationType),
+			)
+		}
+		// The destination is configured in the block.
+		for name, set := range map[string]bool{"api_url": !data.APIURL.IsNull(), "api_key": !data.APIKey.IsNull()} {
+			if set {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid attribute for integration type",
+					fmt.Sprintf("%s is not used when type is %q; configure the %s block instead.", name, integrationType, block),
+				)
+			}
+		}
+	case integrationTypeWebhook:
+		if data.APIURL.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_url"),
+				"Missing required attribute",
+				fmt.Sprintf("api_url is required when type is %q.", integrationTypeWebhook),
+			)
+		}
+	}
+}
+
 func (r *IntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData != nil {
 		if provider, ok := req.ProviderData.(*InfradotsProvider); ok {
@@ -154,6 +378,13 @@ func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateReq
 	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		createReq.Description = data.Description.ValueString()
 	}
+	createReq.Teams = integrationTeamsFromObject(data.Teams)
+	createReq.PagerDuty = integrationPagerDutyFromObject(data.PagerDuty)
+	createReq.Webhook, diags = integrationWebhookFromObject(ctx, data.Webhook)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	reqBody, err := json.Marshal(createReq)
 	if err != nil {
@@ -199,9 +430,22 @@ func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	integrationAPIToModel(&data, apiResp)
-	// api_key is preserved from plan (write-only, not returned by API)
+	// api_key and the secrets of the configuration blocks are preserved from
+	// plan (write-only, not returned by API)
+	resp.Diagnostics.Append(integrationAPIToModel(ctx, &data, apiResp)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !data.VerifyOnCreate.ValueBool() {
+		return
+	}
+
+	// The integration is kept in state, so a failed delivery taints it.
+	if err := testIntegrationDelivery(r.provider, data.OrganizationName.ValueString(), data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Integration test delivery failed",
+			fmt.Sprintf("The integration %q was created, but a test notification could not be delivered: %s. "+
+				"Check the destination settings; the integration will be replaced on the next apply.", data.Name.ValueString(), err),
+		)
+	}
 }
 
 func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -254,7 +498,7 @@ func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Preserve api_key from existing state (write-only secret, not returned by API).
 	existingAPIKey := data.APIKey
-	integrationAPIToModel(&data, apiResp)
+	resp.Diagnostics.Append(integrationAPIToModel(ctx, &data, apiResp)...)
 	data.APIKey = existingAPIKey
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -288,6 +532,34 @@ func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateReq
 	if !plan.Description.Equal(state.Description) {
 		updateReq.Description = plan.Description.ValueString()
 	}
+	// Changed blocks are sent whole; a removed block is sent as null.
+	var err error
+	if !plan.Teams.Equal(state.Teams) {
+		updateReq.Teams, err = json.Marshal(integrationTeamsUpdateFromObject(plan.Teams))
+		if err != nil {
+			resp.Diagnostics.AddError("Error marshaling request", err.Error())
+			return
+		}
+	}
+	if !plan.PagerDuty.Equal(state.PagerDuty) {
+		updateReq.PagerDuty, err = json.Marshal(integrationPagerDutyUpdateFromObject(plan.PagerDuty))
+		if err != nil {
+			resp.Diagnostics.AddError("Error marshaling request", err.Error())
+			return
+		}
+	}
+	if !plan.Webhook.Equal(state.Webhook) {
+		webhook, diags := integrationWebhookUpdateFromObject(ctx, plan.Webhook)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.Webhook, err = json.Marshal(webhook)
+		if err != nil {
+			resp.Diagnostics.AddError("Error marshaling request", err.Error())
+			return
+		}
+	}
 
 	reqBody, err := json.Marshal(updateReq)
 	if err != nil {
@@ -334,8 +606,9 @@ func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	integrationAPIToModel(&plan, apiResp)
-	// api_key is write-only; keep from plan.
+	// api_key and the secrets of the configuration blocks are write-only; keep
+	// from plan.
+	resp.Diagnostics.Append(integrationAPIToModel(ctx, &plan, apiResp)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -432,16 +705,27 @@ func (r *IntegrationResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	var data IntegrationResourceModel
-	data.OrganizationName = types.StringValue(org)
-	integrationAPIToModel(&data, apiResp)
-	// api_key cannot be recovered on import.
+	data := IntegrationResourceModel{
+		OrganizationName: types.StringValue(org),
+		VerifyOnCreate:   types.BoolValue(false),
+		Teams:            types.ObjectNull(integrationTeamsAttrTypes),
+		PagerDuty:        types.ObjectNull(integrationPagerDutyAttrTypes),
+		Webhook:          types.ObjectNull(integrationWebhookAttrTypes),
+	}
+	// api_key and the secrets of the configuration blocks cannot be
+	// recovered on import.
+	resp.Diagnostics.Append(integrationAPIToModel(ctx, &data, apiResp)...)
 	data.APIKey = types.StringNull()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func integrationAPIToModel(data *IntegrationResourceModel, apiResp IntegrationAPIResponse) {
+// integrationAPIToModel maps the API response onto data. The write-only
+// secrets of the configuration blocks are not returned by the API and are kept
+// from the current blocks in data.
+func integrationAPIToModel(ctx context.Context, data *IntegrationResourceModel, apiResp IntegrationAPIResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	data.ID = types.StringValue(apiResp.ID)
 	data.Name = types.StringValue(apiResp.Name)
 	data.Type = types.StringValue(apiResp.Type)
@@ -449,4 +733,167 @@ func integrationAPIToModel(data *IntegrationResourceModel, apiResp IntegrationAP
 	data.Description = types.StringValue(apiResp.Description)
 	data.CreatedAt = types.StringValue(apiResp.CreatedAt.Format(time.RFC3339))
 	data.UpdatedAt = types.StringValue(apiResp.UpdatedAt.Format(time.RFC3339))
+
+	teams := types.ObjectNull(integrationTeamsAttrTypes)
+	if apiResp.Teams != nil {
+		teams = types.ObjectValueMust(integrationTeamsAttrTypes, map[string]attr.Value{
+			"webhook_url": objectStringAttr(data.Teams, "webhook_url"),
+		})
+	}
+	data.Teams = teams
+
+	pagerDuty := types.ObjectNull(integrationPagerDutyAttrTypes)
+	if apiResp.PagerDuty != nil {
+		pagerDuty = types.ObjectValueMust(integrationPagerDutyAttrTypes, map[string]attr.Value{
+			"routing_key":      objectStringAttr(data.PagerDuty, "routing_key"),
+			"default_severity": types.StringValue(apiResp.PagerDuty.DefaultSeverity),
+		})
+	}
+	data.PagerDuty = pagerDuty
+
+	// The webhook block is optional, so an empty block from the API only
+	// stands for one in the configuration.
+	webhook := types.ObjectNull(integrationWebhookAttrTypes)
+	if w := apiResp.Webhook; w != nil && (!data.Webhook.IsNull() || len(w.Headers) > 0 || w.PayloadTemplate != "") {
+		headers := types.MapNull(types.StringType)
+		priorHeaders, _ := data.Webhook.Attributes()["headers"].(types.Map)
+		if len(w.Headers) > 0 || (!data.Webhook.IsNull() && !priorHeaders.IsNull()) {
+			values := w.Headers
+			if values == nil {
+				values = map[string]string{}
+			}
+			var d diag.Diagnostics
+			headers, d = types.MapValueFrom(ctx, types.StringType, values)
+			diags.Append(d...)
+		}
+		payloadTemplate := types.StringNull()
+		if w.PayloadTemplate != "" {
+			payloadTemplate = types.StringValue(w.PayloadTemplate)
+		}
+		webhook = types.ObjectValueMust(integrationWebhookAttrTypes, map[string]attr.Value{
+			"signing_secret":   objectStringAttr(data.Webhook, "signing_secret"),
+			"headers":          headers,
+			"payload_template": payloadTemplate,
+		})
+	}
+	data.Webhook = webhook
+
+	return diags
+}
+
+// objectStringAttr returns the string attribute name of obj, or null when obj
+// is null or unknown.
+func objectStringAttr(obj types.Object, name string) types.String {
+	if obj.IsNull() || obj.IsUnknown() {
+		return types.StringNull()
+	}
+	if v, ok := obj.Attributes()[name].(types.String); ok && !v.IsUnknown() {
+		return v
+	}
+	return types.StringNull()
+}
+
+func integrationTeamsFromObject(obj types.Object) *IntegrationTeamsConfig {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	return &IntegrationTeamsConfig{
+		WebhookURL: objectStringAttr(obj, "webhook_url").ValueString(),
+	}
+}
+
+func integrationPagerDutyFromObject(obj types.Object) *IntegrationPagerDutyConfig {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	return &IntegrationPagerDutyConfig{
+		RoutingKey:      objectStringAttr(obj, "routing_key").ValueString(),
+		DefaultSeverity: objectStringAttr(obj, "default_severity").ValueString(),
+	}
+}
+
+func integrationWebhookFromObject(ctx context.Context, obj types.Object) (*IntegrationWebhookConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, diags
+	}
+	webhook := &IntegrationWebhookConfig{
+		SigningSecret:   objectStringAttr(obj, "signing_secret").ValueString(),
+		PayloadTemplate: objectStringAttr(obj, "payload_template").ValueString(),
+	}
+	if headers, ok := obj.Attributes()["headers"].(types.Map); ok && !headers.IsNull() && !headers.IsUnknown() {
+		diags.Append(headers.ElementsAs(ctx, &webhook.Headers, false)...)
+	}
+	return webhook, diags
+}
+
+func integrationTeamsUpdateFromObject(obj types.Object) *IntegrationTeamsUpdate {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	return &IntegrationTeamsUpdate{
+		WebhookURL: objectStringAttr(obj, "webhook_url").ValueStringPointer(),
+	}
+}
+
+func integrationPagerDutyUpdateFromObject(obj types.Object) *IntegrationPagerDutyUpdate {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	return &IntegrationPagerDutyUpdate{
+		RoutingKey:      objectStringAttr(obj, "routing_key").ValueStringPointer(),
+		DefaultSeverity: objectStringAttr(obj, "default_severity").ValueStringPointer(),
+	}
+}
+
+func integrationWebhookUpdateFromObject(ctx context.Context, obj types.Object) (*IntegrationWebhookUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, diags
+	}
+	webhook := &IntegrationWebhookUpdate{
+		SigningSecret:   objectStringAttr(obj, "signing_secret").ValueStringPointer(),
+		PayloadTemplate: objectStringAttr(obj, "payload_template").ValueStringPointer(),
+	}
+	if headers, ok := obj.Attributes()["headers"].(types.Map); ok && !headers.IsNull() && !headers.IsUnknown() {
+		diags.Append(headers.ElementsAs(ctx, &webhook.Headers, false)...)
+	}
+	return webhook, diags
+}
+
+// testIntegrationDelivery asks the platform to send a test notification
+// through the integration and returns why it could not be delivered.
+func testIntegrationDelivery(p *InfradotsProvider, organizationName, integrationID string) error {
+	url := fmt.Sprintf("https://%s/api/organizations/%s/integrations/%s/test/", p.host, organizationName, integrationID)
+	httpReq, err := http.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return err
+	}
+	if httpResp.StatusCode != 200 {
+		return fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var result IntegrationTestAPIResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return err
+	}
+	if !result.Delivered {
+		if result.Error == "" {
+			return fmt.Errorf("the platform reported the delivery as failed without a reason")
+		}
+		return errors.New(result.Error)
+	}
+	return nil
 }
@@ -0,0 +1,384 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockIntegrationRoundTripper stores the integration created in test-org and
+// returns it without its secrets, like the API.
+type MockIntegrationRoundTripper struct {
+	created    *IntegrationCreateRequest
+	lastUpdate string
+	testResult string
+	tested     bool
+}
+
+func (m *MockIntegrationRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader(`{"error": "Not found"}`)),
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	switch {
+	case req.Method == http.MethodPost && req.URL.Path == "/api/organizations/test-org/integrations/int-1/test/":
+		m.tested = true
+		resp.StatusCode = http.StatusOK
+		resp.Body = io.NopCloser(strings.NewReader(m.testResult))
+		return resp, nil
+	case req.Method == http.MethodPost && req.URL.Path == "/api/organizations/test-org/integrations/":
+		body, _ := io.ReadAll(req.Body)
+		m.created = &IntegrationCreateRequest{}
+		_ = json.Unmarshal(body, m.created)
+		resp.StatusCode = http.StatusCreated
+	case req.Method == http.MethodGet && req.URL.Path == "/api/organizations/test-org/integrations/int-1/" && m.created != nil:
+		resp.StatusCode = http.StatusOK
+	case req.Method == http.MethodPatch && req.URL.Path == "/api/organizations/test-org/integrations/int-1/" && m.created != nil:
+		body, _ := io.ReadAll(req.Body)
+		m.lastUpdate = string(body)
+		var update IntegrationUpdateRequest
+		_ = json.Unmarshal(body, &update)
+		if update.Webhook != nil {
+			var webhook *IntegrationWebhookUpdate
+			_ = json.Unmarshal(update.Webhook, &webhook)
+			m.created.Webhook = nil
+			if webhook != nil {
+				m.created.Webhook = &IntegrationWebhookConfig{
+					SigningSecret:   valueOrEmpty(webhook.SigningSecret),
+					Headers:         webhook.Headers,
+					PayloadTemplate: valueOrEmpty(webhook.PayloadTemplate),
+				}
+			}
+		}
+		resp.StatusCode = http.StatusOK
+	default:
+		return resp, nil
+	}
+
+	out := IntegrationAPIResponse{
+		ID:          "int-1",
+		Name:        m.created.Name,
+		Type:        m.created.Type,
+		APIURL:      m.created.APIURL,
+		Description: m.created.Description,
+	}
+	if m.created.Teams != nil {
+		out.Teams = &IntegrationTeamsConfig{}
+	}
+	if m.created.PagerDuty != nil {
+		out.PagerDuty = &IntegrationPagerDutyConfig{DefaultSeverity: m.created.PagerDuty.DefaultSeverity}
+	}
+	if m.created.Webhook != nil {
+		out.Webhook = &IntegrationWebhookConfig{
+			Headers:         m.created.Webhook.Headers,
+			PayloadTemplate: m.created.Webhook.PayloadTemplate,
+		}
+	}
+	body, _ := json.Marshal(out)
+	resp.Body = io.NopCloser(strings.NewReader(string(body)))
+	return resp, nil
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func testIntegrationModel(integrationType string) IntegrationResourceModel {
+	return IntegrationResourceModel{
+		ID:               types.StringUnknown(),
+		OrganizationName: types.StringValue("test-org"),
+		Name:             types.StringValue("alerts"),
+		Type:             types.StringValue(integrationType),
+		APIURL:           types.StringNull(),
+		APIKey:           types.StringNull(),
+		Description:      types.StringNull(),
+		CreatedAt:        types.StringUnknown(),
+		UpdatedAt:        types.StringUnknown(),
+		Teams:            types.ObjectNull(integrationTeamsAttrTypes),
+		PagerDuty:        types.ObjectNull(integrationPagerDutyAttrTypes),
+		Webhook:          types.ObjectNull(integrationWebhookAttrTypes),
+		VerifyOnCreate:   types.BoolValue(false),
+	}
+}
+
+func testPagerDutyObject() types.Object {
+	return types.ObjectValueMust(integrationPagerDutyAttrTypes, map[string]attr.Value{
+		"routing_key":      types.StringValue("R0UT1NGKEY"),
+		"default_severity": types.StringValue("warning"),
+	})
+}
+
+func testWebhookObject() types.Object {
+	return types.ObjectValueMust(integrationWebhookAttrTypes, map[string]attr.Value{
+		"signing_secret": types.StringValue("0123456789abcdef"),
+		"headers": types.MapValueMust(types.StringType, map[string]attr.Value{
+			"X-Team": types.StringValue("platform"),
+		}),
+		"payload_template": types.StringValue(`{"text": "{{ .Message }}"}`),
+	})
+}
+
+func setupTestIntegrationResource(t *testing.T, testResult string) (*IntegrationResource, *MockIntegrationRoundTripper) {
+	t.Helper()
+	mock := &MockIntegrationRoundTripper{testResult: testResult}
+	return &IntegrationResource{provider: &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}}, mock
+}
+
+func TestIntegrationResource_VerifyOnCreate(t *testing.T) {
+	plan := testIntegrationModel(integrationTypeSlack)
+	var state IntegrationResourceModel
+
+	r, mock := setupTestIntegrationResource(t, `{"delivered": true}`)
+	require.False(t, createTestResource(t, r, &plan, &state).Diagnostics.HasError())
+	assert.False(t, mock.tested)
+
+	plan.VerifyOnCreate = types.BoolValue(true)
+	r, mock = setupTestIntegrationResource(t, `{"delivered": true}`)
+	require.False(t, createTestResource(t, r, &plan, &state).Diagnostics.HasError())
+	assert.True(t, mock.tested)
+	assert.True(t, state.VerifyOnCreate.ValueBool())
+
+	r, _ = setupTestIntegrationResource(t, `{"delivered": false, "error": "channel_not_found"}`)
+	var failed IntegrationResourceModel
+	response := createTestResource(t, r, &plan, &failed)
+	require.True(t, response.Diagnostics.HasError())
+	assert.Equal(t, "Integration test delivery failed", response.Diagnostics.Errors()[0].Summary())
+	assert.Contains(t, response.Diagnostics.Errors()[0].Detail(), "channel_not_found")
+	// The integration stays in state so Terraform taints it.
+	assert.Equal(t, "int-1", failed.ID.ValueString())
+}
+
+func TestIntegrationResource_CreatePagerDuty(t *testing.T) {
+	plan := testIntegrationModel(integrationTypePagerDuty)
+	plan.PagerDuty = testPagerDutyObject()
+
+	r, mock := setupTestIntegrationResource(t, `{"delivered": true}`)
+	var state IntegrationResourceModel
+	require.False(t, createTestResource(t, r, &plan, &state).Diagnostics.HasError())
+	require.NotNil(t, mock.created.PagerDuty)
+	assert.Equal(t, "R0UT1NGKEY", mock.created.PagerDuty.RoutingKey)
+	assert.Equal(t, "warning", mock.created.PagerDuty.DefaultSeverity)
+	assert.Nil(t, mock.created.Webhook)
+
+	// The routing key is write-only and kept from the plan.
+	assert.True(t, state.PagerDuty.Equal(plan.PagerDuty))
+	assert.True(t, state.Webhook.IsNull())
+}
+
+func TestIntegrationResource_CreateWebhookAndRead(t *testing.T) {
+	ctx := context.Background()
+	plan := testIntegrationModel(integrationTypeWebhook)
+	plan.APIURL = types.StringValue("https://example.com/hooks/infradots")
+	plan.Webhook = testWebhookObject()
+
+	r, mock := setupTestIntegrationResource(t, `{"delivered": true}`)
+	var state IntegrationResourceModel
+	require.False(t, createTestResource(t, r, &plan, &state).Diagnostics.HasError())
+	require.NotNil(t, mock.created.Webhook)
+	assert.Equal(t, "0123456789abcdef", mock.created.Webhook.SigningSecret)
+	assert.Equal(t, map[string]string{"X-Team": "platform"}, mock.created.Webhook.Headers)
+	assert.True(t, state.Webhook.Equal(plan.Webhook))
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	request := resource.ReadRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+	require.Empty(t, request.State.Set(ctx, &state))
+	response := &resource.ReadResponse{State: request.State}
+	r.Read(ctx, request, response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+
+	var read IntegrationResourceModel
+	require.Empty(t, response.State.Get(ctx, &read))
+	// The signing secret is not returned by the API and is kept from state.
+	assert.True(t, read.Webhook.Equal(plan.Webhook), read.Webhook.String())
+}
+
+func TestIntegrationResource_ImportTeams(t *testing.T) {
+	ctx := context.Background()
+	plan := testIntegrationModel(integrationTypeTeams)
+	plan.Teams = types.ObjectValueMust(integrationTeamsAttrTypes, map[string]attr.Value{
+		"webhook_url": types.StringValue("https://example.webhook.office.com/webhookb2/abc"),
+	})
+	r, _ := setupTestIntegrationResource(t, `{"delivered": true}`)
+	var created IntegrationResourceModel
+	require.False(t, createTestResource(t, r, &plan, &created).Diagnostics.HasError())
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	response := &resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "test-org:int-1"}, response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+
+	var state IntegrationResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, integrationTypeTeams, state.Type.ValueString())
+	require.False(t, state.Teams.IsNull())
+	assert.True(t, state.Teams.Attributes()["webhook_url"].IsNull())
+}
+
+func TestIntegrationResource_ValidateConfig(t *testing.T) {
+	teams := types.ObjectValueMust(integrationTeamsAttrTypes, map[string]attr.Value{
+		"webhook_url": types.StringValue("https://example.webhook.office.com/webhookb2/abc"),
+	})
+
+	tests := []struct {
+		name       string
+		model      func() IntegrationResourceModel
+		wantErrors []string
+	}{
+		{
+			name:  "slack",
+			model: func() IntegrationResourceModel { return testIntegrationModel(integrationTypeSlack) },
+		},
+		{
+			name: "webhook with signing",
+			model: func() IntegrationResourceModel {
+				m := testIntegrationModel(integrationTypeWebhook)
+				m.APIURL = types.StringValue("https://example.com/hook")
+				m.Webhook = testWebhookObject()
+				return m
+			},
+		},
+		{
+			name:       "webhook without url",
+			model:      func() IntegrationResourceModel { return testIntegrationModel(integrationTypeWebhook) },
+			wantErrors: []string{"api_url"},
+		},
+		{
+			name: "teams",
+			model: func() IntegrationResourceModel {
+				m := testIntegrationModel(integrationTypeTeams)
+				m.Teams = teams
+				return m
+			},
+		},
+		{
+			name:       "teams without block",
+			model:      func() IntegrationResourceModel { return testIntegrationModel(integrationTypeTeams) },
+			wantErrors: []string{"teams"},
+		},
+		{
+			name: "pagerduty with api key",
+			model: func() IntegrationResourceModel {
+				m := testIntegrationModel(integrationTypePagerDuty)
+				m.PagerDuty = testPagerDutyObject()
+				m.APIKey = types.StringValue("key")
+				return m
+			},
+			wantErrors: []string{"api_key"},
+		},
+		{
+			name: "pagerduty block on slack",
+			model: func() IntegrationResourceModel {
+				m := testIntegrationModel(integrationTypeSlack)
+				m.PagerDuty = testPagerDutyObject()
+				m.Webhook = testWebhookObject()
+				return m
+			},
+			wantErrors: []string{"pagerduty", "webhook"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewIntegrationResource().(*IntegrationResource)
+			ctx := context.Background()
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			config := tt.model()
+			config.ID = types.StringNull()
+			config.CreatedAt = types.StringNull()
+			config.UpdatedAt = types.StringNull()
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			require.Empty(t, plan.Set(ctx, &config))
+
+			response := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+			}, response)
+
+			var got []string
+			for _, d := range response.Diagnostics.Errors() {
+				if withPath, ok := d.(interface{ Path() path.Path }); ok {
+					got = append(got, withPath.Path().String())
+				}
+			}
+			assert.ElementsMatch(t, tt.wantErrors, got)
+		})
+	}
+}
+
+func TestIntegrationResource_UpdateClearsWebhookSettings(t *testing.T) {
+	ctx := context.Background()
+	plan := testIntegrationModel(integrationTypeWebhook)
+	plan.APIURL = types.StringValue("https://example.com/hooks/infradots")
+	plan.Webhook = testWebhookObject()
+
+	r, mock := setupTestIntegrationResource(t, `{"delivered": true}`)
+	var state IntegrationResourceModel
+	require.False(t, createTestResource(t, r, &plan, &state).Diagnostics.HasError())
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	update := func(plan IntegrationResourceModel) IntegrationResourceModel {
+		request := resource.UpdateRequest{
+			State: tfsdk.State{Schema: schemaResp.Schema},
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+		}
+		require.Empty(t, request.State.Set(ctx, &state))
+		require.Empty(t, request.Plan.Set(ctx, &plan))
+		response := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Update(ctx, request, response)
+		require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+
+		var updated IntegrationResourceModel
+		require.Empty(t, response.State.Get(ctx, &updated))
+		return updated
+	}
+
+	// Removing signing_secret while keeping the block clears it on the platform.
+	plan = state
+	plan.Webhook = types.ObjectValueMust(integrationWebhookAttrTypes, map[string]attr.Value{
+		"signing_secret":   types.StringNull(),
+		"headers":          state.Webhook.Attributes()["headers"],
+		"payload_template": state.Webhook.Attributes()["payload_template"],
+	})
+	state = update(plan)
+	assert.Contains(t, mock.lastUpdate, `"signing_secret":null`)
+	require.NotNil(t, mock.created.Webhook)
+	assert.Empty(t, mock.created.Webhook.SigningSecret)
+	assert.Equal(t, `{"text": "{{ .Message }}"}`, mock.created.Webhook.PayloadTemplate)
+	assert.True(t, state.Webhook.Attributes()["signing_secret"].IsNull())
+
+	// Removing the whole block sends null rather than an empty object.
+	plan = state
+	plan.Webhook = types.ObjectNull(integrationWebhookAttrTypes)
+	state = update(plan)
+	assert.Contains(t, mock.lastUpdate, `"webhook":null`)
+	assert.Nil(t, mock.created.Webhook)
+	assert.True(t, state.Webhook.IsNull())
+}
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,6 +21,7 @@ import (
 )
 
 var _ resource.Resource = &ModelProviderResource{}
+var _ resource.ResourceWithValidateConfig = &ModelProviderResource{}
 
 func NewModelProviderResource() resource.Resource {
 	return &ModelProviderResource{}
@@ -28,36 +32,87 @@ type ModelProviderResource struct {
 }
 
 type ModelProviderResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	OrganizationName types.String `tfsdk:"organization_name"`
-	Name             types.String `tfsdk:"name"`
-	Provider         types.String `tfsdk:"provider_type"`
-	APIKey           types.String `tfsdk:"api_key"`
-	Description      types.String `tfsdk:"description"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	UpdatedAt        types.String `tfsdk:"updated_at"`
+	ID                types.String `tfsdk:"id"`
+	OrganizationName  types.String `tfsdk:"organization_name"`
+	Name              types.String `tfsdk:"name"`
+	Provider          types.String `tfsdk:"provider_type"`
+	APIKey            types.String `tfsdk:"api_key"`
+	Description       types.String `tfsdk:"description"`
+	BaseURL           types.String `tfsdk:"base_url"`
+	APIVersion        types.String `tfsdk:"api_version"`
+	DeploymentName    types.String `tfsdk:"deployment_name"`
+	Model             types.String `tfsdk:"model"`
+	MaxTokens         types.Int64  `tfsdk:"max_tokens"`
+	RequestsPerMinute types.Int64  `tfsdk:"requests_per_minute"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
 }
 
 type ModelProviderAPIResponse struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Provider    string `json:"provider"`
-	Description string `json:"description"`
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Provider          string `json:"provider"`
+	Description       string `json:"description"`
+	BaseURL           string `json:"base_url"`
+	APIVersion        string `json:"api_version"`
+	DeploymentName    string `json:"deployment_name"`
+	Model             string `json:"model"`
+	MaxTokens         int64  `json:"max_tokens"`
+	RequestsPerMinute int64  `json:"requests_per_minute"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
 }
 
 type ModelProviderCreateRequest struct {
-	Name        string `json:"name"`
-	Provider    string `json:"provider"`
-	APIKey      string `json:"api_key"`
-	Description string `json:"description,omitempty"`
+	Name              string `json:"name"`
+	Provider          string `json:"provider"`
+	APIKey            string `json:"api_key"`
+	Description       string `json:"description,omitempty"`
+	BaseURL           string `json:"base_url,omitempty"`
+	APIVersion        string `json:"api_version,omitempty"`
+	DeploymentName    string `json:"deployment_name,omitempty"`
+	Model             string `json:"model,omitempty"`
+	MaxTokens         int64  `json:"max_tokens,omitempty"`
+	RequestsPerMinute int64  `json:"requests_per_minute,omitempty"`
 }
 
+// ModelProviderUpdateRequest is a partial update. The endpoint settings are
+// pointers so that removing one from the configuration clears it.
 type ModelProviderUpdateRequest struct {
-	Name        string `json:"name,omitempty"`
-	APIKey      string `json:"api_key,omitempty"`
-	Description string `json:"description,omitempty"`
+	Name              string  `json:"name,omitempty"`
+	APIKey            string  `json:"api_key,omitempty"`
+	Description       string  `json:"description,omitempty"`
+	BaseURL           *string `json:"base_url,omitempty"`
+	APIVersion        *string `json:"api_version,omitempty"`
+	DeploymentName    *string `json:"deployment_name,omitempty"`
+	Model             *string `json:"model,omitempty"`
+	MaxTokens         *int64  `json:"max_tokens,omitempty"`
+	RequestsPerMinute *int64  `json:"requests_per_minute,omitempty"`
+}
+
+const (
+	modelProviderOpenAI      = "openai"
+	modelProviderAnthropic   = "anthropic"
+	modelProviderGoogle      = "google"
+	modelProviderAzureOpenAI = "azure_openai"
+	modelProviderCohere      = "cohere"
+	modelProviderLlama       = "llama"
+)
+
+// modelProviderRequiredAttributes lists the endpoint settings each provider
+// type needs. Azure OpenAI serves models from per-resource deployments, and
+// self-hosted Llama has no default endpoint or model.
+var modelProviderRequiredAttributes = map[string][]string{
+	modelProviderAzureOpenAI: {"base_url", "api_version", "deployment_name"},
+	modelProviderLlama:       {"base_url", "model"},
+}
+
+// modelProviderTypeAttributes lists the settings that only apply to some
+// provider types. base_url is accepted by every type, e.g. for an
+// OpenAI-compatible gateway.
+var modelProviderTypeAttributes = map[string][]string{
+	"api_version":     {modelProviderAzureOpenAI},
+	"deployment_name": {modelProviderAzureOpenAI},
 }
 
 func (r *ModelProviderResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -87,7 +142,14 @@ func (r *ModelProviderResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "The provider type. One of: openai, anthropic, google, azure_openai, cohere, llama.",
 				Required:    true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("openai", "anthropic", "google", "azure_openai", "cohere", "llama"),
+					stringvalidator.OneOf(
+						modelProviderOpenAI,
+						modelProviderAnthropic,
+						modelProviderGoogle,
+						modelProviderAzureOpenAI,
+						modelProviderCohere,
+						modelProviderLlama,
+					),
 				},
 			},
 			"api_key": schema.StringAttribute{
@@ -100,6 +162,48 @@ func (r *ModelProviderResource) Schema(_ context.Context, _ resource.SchemaReque
 				Optional:    true,
 				Computed:    true,
 			},
+			"base_url": schema.StringAttribute{
+				Description: "The endpoint the platform's agents send requests to, e.g. https://example.openai.azure.com for azure_openai or the URL of a self-hosted server for llama. Required for azure_openai and llama; defaults to the provider's public API otherwise.",
+				Optional:    true,
+				Validators: []validator.String{
+					httpURLValidator{},
+				},
+			},
+			"api_version": schema.StringAttribute{
+				Description: "The Azure OpenAI API version, e.g. 2024-06-01. Required for azure_openai and not used by other provider types.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"deployment_name": schema.StringAttribute{
+				Description: "The name of the Azure OpenAI deployment to use. Required for azure_openai and not used by other provider types.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"model": schema.StringAttribute{
+				Description: "The model agents use unless a workspace selects another, e.g. claude-sonnet-4-5 or llama-3.1-70b-instruct. Required for llama.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"max_tokens": schema.Int64Attribute{
+				Description: "The maximum number of tokens agents may request per completion.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_minute": schema.Int64Attribute{
+				Description: "The maximum number of requests per minute the platform sends to the provider.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"created_at": schema.StringAttribute{
 				Description: "The timestamp when the model provider was created.",
 				Computed:    true,
@@ -129,10 +233,16 @@ func (r *ModelProviderResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	createReq := ModelProviderCreateRequest{
-		Name:        data.Name.ValueString(),
-		Provider:    data.Provider.ValueString(),
-		APIKey:      data.APIKey.ValueString(),
-		Description: data.Description.ValueString(),
+		Name:              data.Name.ValueString(),
+		Provider:          data.Provider.ValueString(),
+		APIKey:            data.APIKey.ValueString(),
+		Description:       data.Description.ValueString(),
+		BaseURL:           data.BaseURL.ValueString(),
+		APIVersion:        data.APIVersion.ValueString(),
+		DeploymentName:    data.DeploymentName.ValueString(),
+		Model:             data.Model.ValueString(),
+		MaxTokens:         data.MaxTokens.ValueInt64(),
+		RequestsPerMinute: data.RequestsPerMinute.ValueInt64(),
 	}
 
 	reqBody, err := json.Marshal(createReq)
@@ -181,12 +291,7 @@ func (r *ModelProviderResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	data.ID = types.StringValue(mp.ID)
-	data.Name = types.StringValue(mp.Name)
-	data.Provider = types.StringValue(mp.Provider)
-	data.Description = types.StringValue(mp.Description)
-	data.CreatedAt = types.StringValue(mp.CreatedAt)
-	data.UpdatedAt = types.StringValue(mp.UpdatedAt)
+	modelProviderAPIToModel(&data, mp)
 	// api_key is write-only; keep value from plan (already in data)
 
 	diags = resp.State.Set(ctx, &data)
@@ -243,12 +348,7 @@ func (r *ModelProviderResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	data.ID = types.StringValue(mp.ID)
-	data.Name = types.StringValue(mp.Name)
-	data.Provider = types.StringValue(mp.Provider)
-	data.Description = types.StringValue(mp.Description)
-	data.CreatedAt = types.StringValue(mp.CreatedAt)
-	data.UpdatedAt = types.StringValue(mp.UpdatedAt)
+	modelProviderAPIToModel(&data, mp)
 	// api_key is write-only; do NOT overwrite from API response
 
 	diags = resp.State.Set(ctx, &data)
@@ -281,6 +381,24 @@ func (r *ModelProviderResource) Update(ctx context.Context, req resource.UpdateR
 	if !plan.Description.Equal(state.Description) {
 		updateReq.Description = plan.Description.ValueString()
 	}
+	if !plan.BaseURL.Equal(state.BaseURL) {
+		updateReq.BaseURL = clearableString(plan.BaseURL)
+	}
+	if !plan.APIVersion.Equal(state.APIVersion) {
+		updateReq.APIVersion = clearableString(plan.APIVersion)
+	}
+	if !plan.DeploymentName.Equal(state.DeploymentName) {
+		updateReq.DeploymentName = clearableString(plan.DeploymentName)
+	}
+	if !plan.Model.Equal(state.Model) {
+		updateReq.Model = clearableString(plan.Model)
+	}
+	if !plan.MaxTokens.Equal(state.MaxTokens) {
+		updateReq.MaxTokens = clearableInt64(plan.MaxTokens)
+	}
+	if !plan.RequestsPerMinute.Equal(state.RequestsPerMinute) {
+		updateReq.RequestsPerMinute = clearableInt64(plan.RequestsPerMinute)
+	}
 
 	reqBody, err := json.Marshal(updateReq)
 	if err != nil {
@@ -329,12 +447,7 @@ func (r *ModelProviderResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	plan.ID = types.StringValue(mp.ID)
-	plan.Name = types.StringValue(mp.Name)
-	plan.Provider = types.StringValue(mp.Provider)
-	plan.Description = types.StringValue(mp.Description)
-	plan.CreatedAt = types.StringValue(mp.CreatedAt)
-	plan.UpdatedAt = types.StringValue(mp.UpdatedAt)
+	modelProviderAPIToModel(&plan, mp)
 	// api_key: keep plan value (write-only)
 
 	diags = resp.State.Set(ctx, &plan)
@@ -442,15 +555,72 @@ func (r *ModelProviderResource) ImportState(ctx context.Context, req resource.Im
 
 	var data ModelProviderResourceModel
 	data.OrganizationName = types.StringValue(organizationName)
+	modelProviderAPIToModel(&data, mp)
+	// api_key cannot be imported (write-only); leave as unknown/empty
+	data.APIKey = types.StringValue("")
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ValidateConfig checks the endpoint settings required and forbidden by the
+// provider type, so e.g. an Azure OpenAI provider without a deployment is
+// rejected at plan time rather than when an agent first calls it.
+func (r *ModelProviderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ModelProviderResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Provider.IsUnknown() || data.Provider.IsNull() {
+		return
+	}
+	providerType := data.Provider.ValueString()
+
+	isSet := map[string]bool{
+		"base_url":        !data.BaseURL.IsNull(),
+		"api_version":     !data.APIVersion.IsNull(),
+		"deployment_name": !data.DeploymentName.IsNull(),
+		"model":           !data.Model.IsNull(),
+	}
+
+	for _, name := range modelProviderRequiredAttributes[providerType] {
+		if !isSet[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing required attribute",
+				fmt.Sprintf("%s is required when provider_type is %q.", name, providerType),
+			)
+		}
+	}
+	for _, name := range []string{"api_version", "deployment_name"} {
+		if !isSet[name] {
+			continue
+		}
+		supported := modelProviderTypeAttributes[name]
+		if !slices.Contains(supported, providerType) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid attribute for provider type",
+				fmt.Sprintf("%s is not used when provider_type is %q. Remove it, or set provider_type = %q.", name, providerType, supported[0]),
+			)
+		}
+	}
+}
+
+// modelProviderAPIToModel copies the API representation into data. api_key
+// is write-only and left untouched; unset optional settings stay null.
+func modelProviderAPIToModel(data *ModelProviderResourceModel, mp ModelProviderAPIResponse) {
 	data.ID = types.StringValue(mp.ID)
 	data.Name = types.StringValue(mp.Name)
 	data.Provider = types.StringValue(mp.Provider)
 	data.Description = types.StringValue(mp.Description)
+	data.BaseURL = optionalString(data.BaseURL, mp.BaseURL)
+	data.APIVersion = optionalString(data.APIVersion, mp.APIVersion)
+	data.DeploymentName = optionalString(data.DeploymentName, mp.DeploymentName)
+	data.Model = optionalString(data.Model, mp.Model)
+	data.MaxTokens = optionalInt64(mp.MaxTokens)
+	data.RequestsPerMinute = optionalInt64(mp.RequestsPerMinute)
 	data.CreatedAt = types.StringValue(mp.CreatedAt)
 	data.UpdatedAt = types.StringValue(mp.UpdatedAt)
-	// api_key cannot be imported (write-only); leave as unknown/empty
-	data.APIKey = types.StringValue("")
-
-	diags := resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
 }
@@ -0,0 +1,305 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockModelProviderRoundTripper keeps the model providers of test-org in
+// memory and records the body of the last write.
+type MockModelProviderRoundTripper struct {
+	providers map[string]ModelProviderAPIResponse
+	lastBody  map[string]interface{}
+}
+
+func (m *MockModelProviderRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusOK,
+	}
+	resp.Header.Set("Content-Type", "application/json")
+	if m.providers == nil {
+		m.providers = map[string]ModelProviderAPIResponse{}
+	}
+
+	const prefix = "/api/organizations/test-org/model-providers/"
+	id := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, prefix), "/")
+	reply := func(status int, v interface{}) (*http.Response, error) {
+		body, _ := json.Marshal(v)
+		resp.StatusCode = status
+		resp.Body = io.NopCloser(strings.NewReader(string(body)))
+		return resp, nil
+	}
+
+	var body []byte
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+		m.lastBody = map[string]interface{}{}
+		_ = json.Unmarshal(body, &m.lastBody)
+	}
+
+	switch {
+	case !strings.HasPrefix(req.URL.Path, prefix):
+	case req.Method == http.MethodPost && id == "":
+		var create ModelProviderCreateRequest
+		_ = json.Unmarshal(body, &create)
+		mp := ModelProviderAPIResponse{
+			ID:                fmt.Sprintf("mp-%d", len(m.providers)+1),
+			Name:              create.Name,
+			Provider:          create.Provider,
+			Description:       create.Description,
+			BaseURL:           create.BaseURL,
+			APIVersion:        create.APIVersion,
+			DeploymentName:    create.DeploymentName,
+			Model:             create.Model,
+			MaxTokens:         create.MaxTokens,
+			RequestsPerMinute: create.RequestsPerMinute,
+			CreatedAt:         "2026-01-01T00:00:00Z",
+			UpdatedAt:         "2026-01-01T00:00:00Z",
+		}
+		m.providers[mp.ID] = mp
+		return reply(http.StatusCreated, mp)
+	case req.Method == http.MethodGet:
+		if mp, ok := m.providers[id]; ok {
+			return reply(http.StatusOK, mp)
+		}
+	case req.Method == http.MethodPatch:
+		if mp, ok := m.providers[id]; ok {
+			var update ModelProviderUpdateRequest
+			_ = json.Unmarshal(body, &update)
+			if update.Model != nil {
+				mp.Model = *update.Model
+			}
+			if update.MaxTokens != nil {
+				mp.MaxTokens = *update.MaxTokens
+			}
+			m.providers[id] = mp
+			return reply(http.StatusOK, mp)
+		}
+	}
+
+	resp.StatusCode = http.StatusNotFound
+	resp.Body = io.NopCloser(strings.NewReader(`{"error": "Not found"}`))
+	return resp, nil
+}
+
+func setupTestModelProviderResource(t *testing.T) (*ModelProviderResource, *MockModelProviderRoundTripper) {
+	t.Helper()
+	mock := &MockModelProviderRoundTripper{}
+	return &ModelProviderResource{provider: &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}}, mock
+}
+
+func testModelProviderModel(providerType string) ModelProviderResourceModel {
+	return ModelProviderResourceModel{
+		ID:                types.StringUnknown(),
+		OrganizationName:  types.StringValue("test-org"),
+		Name:              types.StringValue("primary"),
+		Provider:          types.StringValue(providerType),
+		APIKey:            types.StringValue("sk-test"),
+		Description:       types.StringValue(""),
+		BaseURL:           types.StringNull(),
+		APIVersion:        types.StringNull(),
+		DeploymentName:    types.StringNull(),
+		Model:             types.StringNull(),
+		MaxTokens:         types.Int64Null(),
+		RequestsPerMinute: types.Int64Null(),
+		CreatedAt:         types.StringUnknown(),
+		UpdatedAt:         types.StringUnknown(),
+	}
+}
+
+func testAzureModelProviderModel() ModelProviderResourceModel {
+	m := testModelProviderModel(modelProviderAzureOpenAI)
+	m.BaseURL = types.StringValue("https://example.openai.azure.com")
+	m.APIVersion = types.StringValue("2024-06-01")
+	m.DeploymentName = types.StringValue("gpt-4o-prod")
+	return m
+}
+
+func TestModelProviderResource_CreateAzure(t *testing.T) {
+	r, mock := setupTestModelProviderResource(t)
+
+	plan := testAzureModelProviderModel()
+	plan.RequestsPerMinute = types.Int64Value(60)
+	var state ModelProviderResourceModel
+	require.False(t, createTestResource(t, r, &plan, &state).Diagnostics.HasError())
+
+	assert.Equal(t, "https://example.openai.azure.com", mock.lastBody["base_url"])
+	assert.Equal(t, "2024-06-01", mock.lastBody["api_version"])
+	assert.Equal(t, "gpt-4o-prod", mock.lastBody["deployment_name"])
+	assert.EqualValues(t, 60, mock.lastBody["requests_per_minute"])
+	assert.NotContains(t, mock.lastBody, "model")
+	assert.NotContains(t, mock.lastBody, "max_tokens")
+
+	assert.Equal(t, "mp-1", state.ID.ValueString())
+	assert.Equal(t, "gpt-4o-prod", state.DeploymentName.ValueString())
+	assert.Equal(t, int64(60), state.RequestsPerMinute.ValueInt64())
+	assert.True(t, state.Model.IsNull())
+	assert.True(t, state.MaxTokens.IsNull())
+	assert.Equal(t, "sk-test", state.APIKey.ValueString())
+}
+
+func TestModelProviderResource_UpdateClearsSettings(t *testing.T) {
+	r, mock := setupTestModelProviderResource(t)
+	ctx := context.Background()
+
+	plan := testModelProviderModel(modelProviderOpenAI)
+	plan.Model = types.StringValue("gpt-4o")
+	plan.MaxTokens = types.Int64Value(4096)
+	var state ModelProviderResourceModel
+	require.False(t, createTestResource(t, r, &plan, &state).Diagnostics.HasError())
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	updated := state
+	updated.Model = types.StringValue("gpt-4.1")
+	updated.MaxTokens = types.Int64Null()
+	request := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	require.Empty(t, request.Plan.Set(ctx, &updated))
+	require.Empty(t, request.State.Set(ctx, &state))
+
+	response := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, request, response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+
+	assert.Equal(t, "gpt-4.1", mock.lastBody["model"])
+	assert.EqualValues(t, 0, mock.lastBody["max_tokens"])
+	assert.NotContains(t, mock.lastBody, "base_url")
+
+	var got ModelProviderResourceModel
+	require.Empty(t, response.State.Get(ctx, &got))
+	assert.Equal(t, "gpt-4.1", got.Model.ValueString())
+	assert.True(t, got.MaxTokens.IsNull())
+	assert.True(t, got.BaseURL.IsNull())
+}
+
+func TestModelProviderResource_ImportState(t *testing.T) {
+	r, _ := setupTestModelProviderResource(t)
+	ctx := context.Background()
+	plan := testAzureModelProviderModel()
+	var created ModelProviderResourceModel
+	require.False(t, createTestResource(t, r, &plan, &created).Diagnostics.HasError())
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	response := &resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "test-org:mp-1"}, response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+
+	var state ModelProviderResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "https://example.openai.azure.com", state.BaseURL.ValueString())
+	assert.Equal(t, "2024-06-01", state.APIVersion.ValueString())
+	assert.True(t, state.Model.IsNull())
+	assert.True(t, state.RequestsPerMinute.IsNull())
+}
+
+func TestModelProviderResource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		model      func() ModelProviderResourceModel
+		wantErrors []string
+	}{
+		{
+			name:  "openai",
+			model: func() ModelProviderResourceModel { return testModelProviderModel(modelProviderOpenAI) },
+		},
+		{
+			name: "openai compatible gateway",
+			model: func() ModelProviderResourceModel {
+				m := testModelProviderModel(modelProviderOpenAI)
+				m.BaseURL = types.StringValue("https://llm-gateway.example.com/v1")
+				m.Model = types.StringValue("gpt-4o")
+				return m
+			},
+		},
+		{
+			name:  "azure openai",
+			model: testAzureModelProviderModel,
+		},
+		{
+			name:       "azure openai without deployment",
+			model:      func() ModelProviderResourceModel { return testModelProviderModel(modelProviderAzureOpenAI) },
+			wantErrors: []string{"base_url", "api_version", "deployment_name"},
+		},
+		{
+			name: "self-hosted llama",
+			model: func() ModelProviderResourceModel {
+				m := testModelProviderModel(modelProviderLlama)
+				m.BaseURL = types.StringValue("http://llama.internal:8080")
+				m.Model = types.StringValue("llama-3.1-70b-instruct")
+				return m
+			},
+		},
+		{
+			name: "llama without model",
+			model: func() ModelProviderResourceModel {
+				m := testModelProviderModel(modelProviderLlama)
+				m.BaseURL = types.StringValue("http://llama.internal:8080")
+				return m
+			},
+			wantErrors: []string{"model"},
+		},
+		{
+			name: "azure settings on anthropic",
+			model: func() ModelProviderResourceModel {
+				m := testModelProviderModel(modelProviderAnthropic)
+				m.APIVersion = types.StringValue("2024-06-01")
+				m.DeploymentName = types.StringValue("claude")
+				return m
+			},
+			wantErrors: []string{"api_version", "deployment_name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewModelProviderResource().(*ModelProviderResource)
+			ctx := context.Background()
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			config := tt.model()
+			config.ID = types.StringNull()
+			config.CreatedAt = types.StringNull()
+			config.UpdatedAt = types.StringNull()
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			require.Empty(t, plan.Set(ctx, &config))
+
+			response := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+			}, response)
+
+			var got []string
+			for _, d := range response.Diagnostics.Errors() {
+				if withPath, ok := d.(interface{ Path() path.Path }); ok {
+					got = append(got, withPath.Path().String())
+				}
+			}
+			assert.ElementsMatch(t, tt.wantErrors, got)
+		})
+	}
+}
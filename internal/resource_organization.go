@@ -43,6 +43,7 @@ type OrganizationResourceModel struct {
 	AutoImplementChanges             types.Bool   `tfsdk:"auto_implement_changes"`
 	ApprovalReminderIntervalHours    types.Int64  `tfsdk:"approval_reminder_interval_hours"`
 	WorkspaceInterconnectionsEnabled types.Bool   `tfsdk:"workspace_interconnections_enabled"`
+	DefaultModelProviderID           types.String `tfsdk:"default_model_provider_id"`
 }
 
 type OrganizationAPIResponse struct {
@@ -61,6 +62,7 @@ type OrganizationAPIResponse struct {
 	AutoImplementChanges             bool           `json:"auto_implement_changes"`
 	ApprovalReminderIntervalHours    *int64         `json:"approval_reminder_interval_hours"`
 	WorkspaceInterconnectionsEnabled bool           `json:"workspace_interconnections_enabled"`
+	DefaultModelProviderID           *string        `json:"default_model_provider_id"`
 }
 
 type Member struct {
@@ -72,15 +74,16 @@ type Team struct {
 }
 
 type OrganizationUpdateRequest struct {
-	Name                             string         `json:"name,omitempty"`
-	ExecutionMode                    string         `json:"execution_mode,omitempty"`
-	AgentsEnabled                    bool           `json:"agents_enabled,omitempty"`
-	Tags                             map[string]any `json:"tags,omitempty"`
-	DriftDetectionEnabled            *bool          `json:"drift_detection_enabled,omitempty"`
-	RemedyDrift                      *bool          `json:"remedy_drift,omitempty"`
-	AutoImplementChanges             *bool          `json:"auto_implement_changes,omitempty"`
-	ApprovalReminderIntervalHours    *int64         `json:"approval_reminder_interval_hours,omitempty"`
-	WorkspaceInterconnectionsEnabled *bool          `json:"workspace_interconnections_enabled,omitempty"`
+	Name                             string          `json:"name,omitempty"`
+	ExecutionMode                    string          `json:"execution_mode,omitempty"`
+	AgentsEnabled                    bool            `json:"agents_enabled,omitempty"`
+	Tags                             map[string]any  `json:"tags,omitempty"`
+	DriftDetectionEnabled            *bool           `json:"drift_detection_enabled,omitempty"`
+	RemedyDrift                      *bool           `json:"remedy_drift,omitempty"`
+	AutoImplementChanges             *bool           `json:"auto_implement_changes,omitempty"`
+	ApprovalReminderIntervalHours    *int64          `json:"approval_reminder_interval_hours,omitempty"`
+	WorkspaceInterconnectionsEnabled *bool           `json:"workspace_interconnections_enabled,omitempty"`
+	DefaultModelProviderID           json.RawMessage `json:"default_model_provider_id,omitempty"`
 }
 
 type OrganizationResource struct {
@@ -162,6 +165,13 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "How often (in hours) to send approval reminder notifications for jobs pending approval. Defaults to 1. Set to null to disable reminders.",
 				Optional:    true,
 			},
+			"default_model_provider_id": schema.StringAttribute{
+				Description: "ID of the model provider agents use in workspaces that do not select their own, e.g. the id of an infradots_model_provider resource.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -202,6 +212,10 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		createReq.ApprovalReminderIntervalHours = &v
 	}
 
+	if !data.DefaultModelProviderID.IsNull() {
+		createReq.DefaultModelProviderID = nullableString(data.DefaultModelProviderID)
+	}
+
 	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
 		var tags map[string]string
 		diags = data.Tags.ElementsAs(ctx, &tags, false)
@@ -269,6 +283,7 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 	data.RemedyDrift = types.BoolValue(organization.RemedyDrift)
 	data.AutoImplementChanges = types.BoolValue(organization.AutoImplementChanges)
 	data.WorkspaceInterconnectionsEnabled = types.BoolValue(organization.WorkspaceInterconnectionsEnabled)
+	data.DefaultModelProviderID = optionalStringPointer(organization.DefaultModelProviderID)
 
 	if organization.ApprovalReminderIntervalHours != nil {
 		data.ApprovalReminderIntervalHours = types.Int64Value(*organization.ApprovalReminderIntervalHours)
@@ -348,6 +363,7 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 	data.RemedyDrift = types.BoolValue(organization.RemedyDrift)
 	data.AutoImplementChanges = types.BoolValue(organization.AutoImplementChanges)
 	data.WorkspaceInterconnectionsEnabled = types.BoolValue(organization.WorkspaceInterconnectionsEnabled)
+	data.DefaultModelProviderID = optionalStringPointer(organization.DefaultModelProviderID)
 
 	if organization.ApprovalReminderIntervalHours != nil {
 		data.ApprovalReminderIntervalHours = types.Int64Value(*organization.ApprovalReminderIntervalHours)
@@ -425,6 +441,10 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 	}
 
+	if !plan.DefaultModelProviderID.Equal(state.DefaultModelProviderID) {
+		updateReq.DefaultModelProviderID = nullableString(plan.DefaultModelProviderID)
+	}
+
 	if !plan.Tags.Equal(state.Tags) && !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
 		var tags map[string]string
 		diags = plan.Tags.ElementsAs(ctx, &tags, false)
@@ -493,6 +513,7 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	plan.RemedyDrift = types.BoolValue(organization.RemedyDrift)
 	plan.AutoImplementChanges = types.BoolValue(organization.AutoImplementChanges)
 	plan.WorkspaceInterconnectionsEnabled = types.BoolValue(organization.WorkspaceInterconnectionsEnabled)
+	plan.DefaultModelProviderID = optionalStringPointer(organization.DefaultModelProviderID)
 
 	if organization.ApprovalReminderIntervalHours != nil {
 		plan.ApprovalReminderIntervalHours = types.Int64Value(*organization.ApprovalReminderIntervalHours)
@@ -616,6 +637,7 @@ func (r *OrganizationResource) ImportState(ctx context.Context, req resource.Imp
 	data.RemedyDrift = types.BoolValue(organization.RemedyDrift)
 	data.AutoImplementChanges = types.BoolValue(organization.AutoImplementChanges)
 	data.WorkspaceInterconnectionsEnabled = types.BoolValue(organization.WorkspaceInterconnectionsEnabled)
+	data.DefaultModelProviderID = optionalStringPointer(organization.DefaultModelProviderID)
 
 	if organization.ApprovalReminderIntervalHours != nil {
 		data.ApprovalReminderIntervalHours = types.Int64Value(*organization.ApprovalReminderIntervalHours)
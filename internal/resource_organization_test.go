@@ -16,7 +16,9 @@ import (
 )
 
 // MockRoundTripper implements http.RoundTripper for testing purposes
-type MockRoundTripper struct{}
+type MockRoundTripper struct {
+	lastPatch string
+}
 
 func (m *MockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	// Create a mocked response based on the request
@@ -110,6 +112,8 @@ func (m *MockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error)
 
 	// Handle Update (PATCH to /api/organizations/{id})
 	if req.Method == http.MethodPatch && strings.Contains(url, "/api/organizations/2e240d2c-78e0-4832-abdc-daa33477a238") {
+		body, _ := io.ReadAll(req.Body)
+		m.lastPatch = string(body)
 		jsonResp := `{
 			"id": "2e240d2c-78e0-4832-abdc-daa33477a238",
 			"name": "updated-org",
@@ -122,7 +126,8 @@ func (m *MockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error)
 			"execution_mode": "Local",
 			"agents_enabled": false,
 			"workspace_interconnections_enabled": true,
-			"approval_reminder_interval_hours": 8
+			"approval_reminder_interval_hours": 8,
+			"default_model_provider_id": "mp-12345"
 		}`
 		resp.Body = io.NopCloser(strings.NewReader(jsonResp))
 		return resp, nil
@@ -286,6 +291,7 @@ func TestOrganizationResource_Update(t *testing.T) {
 	plan.ExecutionMode = types.StringValue("Local")
 	plan.AgentsEnabled = types.BoolValue(false)
 	plan.WorkspaceInterconnectionsEnabled = types.BoolValue(true)
+	plan.DefaultModelProviderID = types.StringValue("mp-12345")
 	plan.Tags = types.MapNull(types.StringType)
 
 	// Create request/response objects
@@ -336,6 +342,42 @@ func TestOrganizationResource_Update(t *testing.T) {
 	assert.True(t, newState.WorkspaceInterconnectionsEnabled.ValueBool())
 	assert.Equal(t, "2025-07-07T12:01:00Z", newState.UpdatedAt.ValueString())
 	assert.Equal(t, int64(8), newState.ApprovalReminderIntervalHours.ValueInt64())
+	assert.Equal(t, "mp-12345", newState.DefaultModelProviderID.ValueString())
+
+	mock := r.provider.client.Transport.(*MockRoundTripper)
+	assert.Contains(t, mock.lastPatch, `"default_model_provider_id":"mp-12345"`)
+}
+
+func TestOrganizationResource_Update_ClearsDefaultModelProvider(t *testing.T) {
+	r := setupTestResource(t)
+	ctx := context.Background()
+
+	state := OrganizationResourceModel{
+		ID:                     types.StringValue("2e240d2c-78e0-4832-abdc-daa33477a238"),
+		Name:                   types.StringValue("test-org"),
+		ExecutionMode:          types.StringValue("remote"),
+		AgentsEnabled:          types.BoolValue(true),
+		DefaultModelProviderID: types.StringValue("mp-12345"),
+		Tags:                   types.MapNull(types.StringType),
+	}
+	plan := state
+	plan.DefaultModelProviderID = types.StringNull()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	request := resource.UpdateRequest{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+	}
+	require.Empty(t, request.State.Set(ctx, &state))
+	require.Empty(t, request.Plan.Set(ctx, &plan))
+	response := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+	// The provider is cleared with null; an empty ID is not a valid reference.
+	mock := r.provider.client.Transport.(*MockRoundTripper)
+	assert.Contains(t, mock.lastPatch, `"default_model_provider_id":null`)
 }
 
 func TestOrganizationResource_Delete(t *testing.T) {
@@ -417,6 +459,11 @@ func TestOrganizationResource_Schema(t *testing.T) {
 	workspaceInterconnAttr := attrs["workspace_interconnections_enabled"].(schema.BoolAttribute)
 	assert.True(t, workspaceInterconnAttr.Optional)
 	assert.True(t, workspaceInterconnAttr.Computed)
+
+	assert.Contains(t, attrs, "default_model_provider_id")
+	defaultModelProviderAttr := attrs["default_model_provider_id"].(schema.StringAttribute)
+	assert.True(t, defaultModelProviderAttr.Optional)
+	assert.False(t, defaultModelProviderAttr.Computed)
 }
 
 func TestOrganizationResource_ImportState(t *testing.T) {
@@ -7,19 +7,28 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
+	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource              = &PermissionResource{}
-	_ resource.ResourceWithConfigure = &PermissionResource{}
+	_ resource.Resource                   = &PermissionResource{}
+	_ resource.ResourceWithConfigure      = &PermissionResource{}
+	_ resource.ResourceWithModifyPlan     = &PermissionResource{}
+	_ resource.ResourceWithValidateConfig = &PermissionResource{}
 )
 
 func NewPermissionResource() resource.Resource {
@@ -27,12 +36,14 @@ func NewPermissionResource() resource.Resource {
 }
 
 type PermissionResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	OrganizationName types.String `tfsdk:"organization_name"`
-	TeamID           types.String `tfsdk:"team_id"`
-	UserEmail        types.String `tfsdk:"user_email"`
-	Permission       types.String `tfsdk:"permission"`
-	WorkspaceName    types.String `tfsdk:"workspace_name"`
+	ID                 types.String `tfsdk:"id"`
+	OrganizationName   types.String `tfsdk:"organization_name"`
+	TeamID             types.String `tfsdk:"team_id"`
+	UserEmail          types.String `tfsdk:"user_email"`
+	Permission         types.String `tfsdk:"permission"`
+	WorkspaceName      types.String `tfsdk:"workspace_name"`
+	WorkspaceTags      types.Map    `tfsdk:"workspace_tags"`
+	SelectedWorkspaces types.List   `tfsdk:"selected_workspaces"`
 }
 
 type PermissionAPIResponse struct {
@@ -79,20 +90,40 @@ func (r *PermissionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 			},
 			"team_id": schema.StringAttribute{
-				Description: "The ID of the team to assign the permission to. Mutually exclusive with user_email.",
+				Description: "The ID of the team to assign the permission to. Exactly one of team_id and user_email must be set.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_email")),
+				},
 			},
 			"user_email": schema.StringAttribute{
-				Description: "The email of the user to assign the permission to. Mutually exclusive with team_id.",
+				Description: "The email of the user to assign the permission to. Exactly one of team_id and user_email must be set.",
 				Optional:    true,
 			},
 			"permission": schema.StringAttribute{
 				Description: "The permission codename (e.g., read_workspaces, write_workspaces, read_organizations, write_organizations, read_teams, write_teams).",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(knownPermissionCodenames()...),
+				},
 			},
 			"workspace_name": schema.StringAttribute{
-				Description: "The workspace name to scope this permission to. If not set, the permission is organization-level.",
+				Description: "The workspace name to scope this permission to. If neither this nor workspace_tags is set, the permission is organization-level.",
+				Optional:    true,
+			},
+			"workspace_tags": schema.MapAttribute{
+				Description: "Scope this permission to every workspace whose tags contain all of these key/value pairs. Mutually exclusive with workspace_name.",
 				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ConflictsWith(path.MatchRoot("workspace_name")),
+				},
+			},
+			"selected_workspaces": schema.ListAttribute{
+				Description: "The workspaces this permission applies to, resolved from workspace_name or workspace_tags at plan time.",
+				Computed:    true,
+				ElementType: types.StringType,
 			},
 		},
 	}
@@ -106,6 +137,22 @@ func (r *PermissionResource) Configure(ctx context.Context, req resource.Configu
 	}
 }
 
+// ValidateConfig rejects codenames that cannot be granted at the configured
+// scope, e.g. read_teams on a workspace.
+func (r *PermissionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PermissionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Permission.IsNull() || data.Permission.IsUnknown() {
+		return
+	}
+
+	// An unknown workspace_name still scopes the permission to a workspace.
+	workspaceScoped := data.WorkspaceName.IsUnknown() || data.WorkspaceName.ValueString() != "" || !data.WorkspaceTags.IsNull()
+	if msg := permissionScopeError(data.Permission.ValueString(), workspaceScoped); msg != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("permission"), "Invalid permission scope", msg)
+	}
+}
+
 func (r *PermissionResource) computeID(data *PermissionResourceModel) string {
 	parts := []string{
 		data.OrganizationName.ValueString(),
@@ -118,6 +165,8 @@ func (r *PermissionResource) computeID(data *PermissionResourceModel) string {
 	}
 	if !data.WorkspaceName.IsNull() && data.WorkspaceName.ValueString() != "" {
 		parts = append(parts, data.WorkspaceName.ValueString())
+	} else if !data.WorkspaceTags.IsNull() {
+		parts = append(parts, "tags:"+workspaceSelectorString(workspaceSelectorFromMap(data.WorkspaceTags)))
 	}
 	return strings.Join(parts, ":")
 }
@@ -205,14 +254,19 @@ func (r *PermissionResource) sendOrgPermissions(data *PermissionResourceModel, p
 	return httpResp.StatusCode, string(respBody), nil
 }
 
-func (r *PermissionResource) sendWorkspacePermissions(data *PermissionResourceModel, perms []string) (int, string, error) {
+// sendWorkspacePermissions replaces the permissions of the principal on each
+// of the given workspaces.
+func (r *PermissionResource) sendWorkspacePermissions(data *PermissionResourceModel, workspaces map[string][]string) (int, string, error) {
+	// A tag selector may match no workspace; there is nothing to send then.
+	if len(workspaces) == 0 {
+		return http.StatusOK, "", nil
+	}
+
 	apiUrl := fmt.Sprintf("https://%s/api/permissions/%s/workspaces/",
 		r.provider.host, data.OrganizationName.ValueString())
 
 	wsReq := WorkspacePermissionRequest{
-		Workspaces: map[string][]string{
-			data.WorkspaceName.ValueString(): perms,
-		},
+		Workspaces: workspaces,
 	}
 	if !data.TeamID.IsNull() && data.TeamID.ValueString() != "" {
 		wsReq.Team = data.TeamID.ValueString()
@@ -243,6 +297,152 @@ func (r *PermissionResource) sendWorkspacePermissions(data *PermissionResourceMo
 	return httpResp.StatusCode, string(respBody), nil
 }
 
+// workspaces returns the workspaces a workspace-level permission applies to
+// and the selected_workspaces value to store for them.
+func (r *PermissionResource) workspaces(ctx context.Context, data *PermissionResourceModel) ([]string, types.List, diag.Diagnostics) {
+	if !data.WorkspaceTags.IsNull() {
+		return selectedWorkspaceNames(ctx, r.provider, data.OrganizationName, data.WorkspaceTags, data.SelectedWorkspaces, "")
+	}
+	if !data.WorkspaceName.IsNull() && data.WorkspaceName.ValueString() != "" {
+		list, diags := types.ListValueFrom(ctx, types.StringType, []string{data.WorkspaceName.ValueString()})
+		return []string{data.WorkspaceName.ValueString()}, list, diags
+	}
+	return nil, types.ListNull(types.StringType), nil
+}
+
+// updateWorkspacePermissions rewrites the codenames the principal of data
+// holds on each workspace with update and sends them in one request. Every
+// workspace scope is locked while its codenames are read and replaced, in
+// sorted order so that concurrent updates cannot deadlock.
+func (r *PermissionResource) updateWorkspacePermissions(data *PermissionResourceModel, workspaces []string, update func(workspace string, existing []string) []string) (int, string, error) {
+	sorted := slices.Sorted(slices.Values(workspaces))
+	perms := make(map[string][]string, len(sorted))
+	for _, ws := range sorted {
+		unlock := lockPermissionScope(permissionScopeKey(data, ws))
+		defer unlock()
+
+		existing, err := r.readWorkspacePermissions(data, ws)
+		if err != nil {
+			return 0, "", err
+		}
+		perms[ws] = update(ws, existing)
+		if perms[ws] == nil {
+			perms[ws] = []string{}
+		}
+	}
+	return r.sendWorkspacePermissions(data, perms)
+}
+
+// listPermissionGrants returns the permission grants of every principal in
+// the organization: organization-level ones, or those on workspace when it is
+// set.
+func listPermissionGrants(p *InfradotsProvider, organizationName, workspace string) ([]PermissionAPIResponse, error) {
+	apiUrl := fmt.Sprintf("https://%s/api/permissions/%s/", p.host, organizationName)
+	if workspace != "" {
+		apiUrl = fmt.Sprintf("https://%s/api/permissions/%s/workspaces/?workspace=%s",
+			p.host, organizationName, url.QueryEscape(workspace))
+	}
+
+	reqHttp, err := http.NewRequest(http.MethodGet, apiUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(reqHttp)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var grants []PermissionAPIResponse
+	if err := json.Unmarshal(respBody, &grants); err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
+// permissionScope builds the PermissionResource helpers and model for one
+// principal at one scope, for resources that grant permissions through the
+// same API (roles, authoritative permission sets).
+func permissionScope(p *InfradotsProvider, organizationName, teamID, userEmail, workspaceName types.String) (*PermissionResource, *PermissionResourceModel) {
+	return &PermissionResource{provider: p}, &PermissionResourceModel{
+		OrganizationName: organizationName,
+		TeamID:           teamID,
+		UserEmail:        userEmail,
+		WorkspaceName:    workspaceName,
+		WorkspaceTags:    types.MapNull(types.StringType),
+	}
+}
+
+// permissionScopeKey identifies one principal at one scope, the unit the
+// permissions API reads and replaces. An empty workspace is the organization.
+func permissionScopeKey(data *PermissionResourceModel, workspace string) string {
+	principal := "team:" + data.TeamID.ValueString()
+	if !data.UserEmail.IsNull() && data.UserEmail.ValueString() != "" {
+		principal = "user:" + data.UserEmail.ValueString()
+	}
+	return data.OrganizationName.ValueString() + "/" + principal + "/" + workspace
+}
+
+// permissionScopeLocks serializes read-modify-write cycles on the codenames of
+// one principal at one scope. The API only accepts the full set, so two
+// resources granting to the same principal in parallel would otherwise
+// overwrite each other.
+var permissionScopeLocks sync.Map
+
+func lockPermissionScope(key string) func() {
+	v, _ := permissionScopeLocks.LoadOrStore(key, &sync.Mutex{})
+	mu := v.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// readScopePermissions returns every codename the principal of data holds on
+// workspace_name, or at organization level when it is not set.
+func (r *PermissionResource) readScopePermissions(data *PermissionResourceModel) ([]string, error) {
+	if !data.WorkspaceName.IsNull() && data.WorkspaceName.ValueString() != "" {
+		return r.readWorkspacePermissions(data, data.WorkspaceName.ValueString())
+	}
+	return r.readExistingOrgPermissions(data)
+}
+
+// setScopePermissions replaces the codenames the principal of data holds on
+// workspace_name, or at organization level when it is not set.
+func (r *PermissionResource) setScopePermissions(data *PermissionResourceModel, perms []string) error {
+	if perms == nil {
+		perms = []string{}
+	}
+
+	var statusCode int
+	var body string
+	var err error
+	if !data.WorkspaceName.IsNull() && data.WorkspaceName.ValueString() != "" {
+		statusCode, body, err = r.sendWorkspacePermissions(data, map[string][]string{data.WorkspaceName.ValueString(): perms})
+	} else {
+		statusCode, body, err = r.sendOrgPermissions(data, perms)
+	}
+	if err != nil {
+		return err
+	}
+	if statusCode != http.StatusOK && statusCode != http.StatusCreated {
+		return fmt.Errorf("status: %d, body: %s", statusCode, body)
+	}
+	return nil
+}
+
+func isWorkspaceLevelPermission(data *PermissionResourceModel) bool {
+	return (!data.WorkspaceName.IsNull() && data.WorkspaceName.ValueString() != "") || !data.WorkspaceTags.IsNull()
+}
+
 func addToSet(existing []string, perm string) []string {
 	for _, p := range existing {
 		if p == perm {
@@ -275,10 +475,17 @@ func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	isWorkspaceLevel := !data.WorkspaceName.IsNull() && data.WorkspaceName.ValueString() != ""
+	workspaces, selected, diags := r.workspaces(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.SelectedWorkspaces = selected
 
-	if isWorkspaceLevel {
-		statusCode, body, err := r.sendWorkspacePermissions(&data, []string{data.Permission.ValueString()})
+	if isWorkspaceLevelPermission(&data) {
+		statusCode, body, err := r.updateWorkspacePermissions(&data, workspaces, func(_ string, existing []string) []string {
+			return addToSet(existing, data.Permission.ValueString())
+		})
 		if err != nil {
 			resp.Diagnostics.AddError("HTTP request failed", err.Error())
 			return
@@ -288,6 +495,9 @@ func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequ
 			return
 		}
 	} else {
+		unlock := lockPermissionScope(permissionScopeKey(&data, ""))
+		defer unlock()
+
 		existing, err := r.readExistingOrgPermissions(&data)
 		if err != nil {
 			resp.Diagnostics.AddError("Error reading existing permissions", err.Error())
@@ -319,6 +529,11 @@ func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	if !data.WorkspaceTags.IsNull() {
+		r.readSelectedWorkspaces(ctx, &data, resp)
+		return
+	}
+
 	// Determine which endpoint to use
 	var apiUrl string
 	if !data.WorkspaceName.IsNull() && data.WorkspaceName.ValueString() != "" {
@@ -404,11 +619,100 @@ func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	_, data.SelectedWorkspaces, diags = r.workspaces(ctx, &data)
+	resp.Diagnostics.Append(diags...)
 	data.ID = types.StringValue(r.computeID(&data))
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
+// readSelectedWorkspaces refreshes a tag-selected permission. Only the
+// previously selected workspaces that still grant the permission are kept, so
+// the next plan re-applies it wherever it was removed or newly matches.
+func (r *PermissionResource) readSelectedWorkspaces(ctx context.Context, data *PermissionResourceModel, resp *resource.ReadResponse) {
+	var selected []string
+	if !data.SelectedWorkspaces.IsNull() && !data.SelectedWorkspaces.IsUnknown() {
+		diags := data.SelectedWorkspaces.ElementsAs(ctx, &selected, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	applied := []string{}
+	for _, ws := range selected {
+		perms, err := r.readWorkspacePermissions(data, ws)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading workspace permissions", err.Error())
+			return
+		}
+		for _, p := range perms {
+			if p == data.Permission.ValueString() {
+				applied = append(applied, ws)
+				break
+			}
+		}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, applied)
+	resp.Diagnostics.Append(diags...)
+	data.SelectedWorkspaces = list
+	data.ID = types.StringValue(r.computeID(data))
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// readWorkspacePermissions returns the permission codenames the principal
+// holds on a single workspace. A missing workspace has none.
+func (r *PermissionResource) readWorkspacePermissions(data *PermissionResourceModel, workspace string) ([]string, error) {
+	u, _ := url.Parse(fmt.Sprintf("https://%s/api/permissions/%s/workspaces/",
+		r.provider.host, data.OrganizationName.ValueString()))
+	q := u.Query()
+	if !data.UserEmail.IsNull() && data.UserEmail.ValueString() != "" {
+		q.Set("user", data.UserEmail.ValueString())
+	}
+	if !data.TeamID.IsNull() && data.TeamID.ValueString() != "" {
+		q.Set("team", data.TeamID.ValueString())
+	}
+	q.Set("workspace", workspace)
+	u.RawQuery = q.Encode()
+
+	reqHttp, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
+
+	httpResp, err := r.provider.client.Do(reqHttp)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == 404 {
+		return nil, nil
+	}
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var permissions []PermissionAPIResponse
+	if err := json.Unmarshal(respBody, &permissions); err != nil {
+		return nil, err
+	}
+
+	var perms []string
+	for _, p := range permissions {
+		perms = append(perms, p.Permission)
+	}
+	return perms, nil
+}
+
 func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var state PermissionResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -424,10 +728,34 @@ func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	isWorkspaceLevel := !plan.WorkspaceName.IsNull() && plan.WorkspaceName.ValueString() != ""
-
-	if isWorkspaceLevel {
-		statusCode, body, err := r.sendWorkspacePermissions(&plan, []string{plan.Permission.ValueString()})
+	workspaces, selected, diags := r.workspaces(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.SelectedWorkspaces = selected
+
+	if isWorkspaceLevelPermission(&plan) {
+		// Revoke the previous codename from the previously selected workspaces
+		// and grant the planned one on the selected ones.
+		var previous []string
+		if !state.SelectedWorkspaces.IsNull() && !state.SelectedWorkspaces.IsUnknown() {
+			diags = state.SelectedWorkspaces.ElementsAs(ctx, &previous, false)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		all := append(slices.Clone(workspaces), stringSetDifference(previous, workspaces)...)
+		statusCode, body, err := r.updateWorkspacePermissions(&plan, all, func(ws string, existing []string) []string {
+			if slices.Contains(previous, ws) {
+				existing = removeFromSet(existing, state.Permission.ValueString())
+			}
+			if slices.Contains(workspaces, ws) {
+				existing = addToSet(existing, plan.Permission.ValueString())
+			}
+			return existing
+		})
 		if err != nil {
 			resp.Diagnostics.AddError("HTTP request failed", err.Error())
 			return
@@ -437,6 +765,9 @@ func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequ
 			return
 		}
 	} else {
+		unlock := lockPermissionScope(permissionScopeKey(&plan, ""))
+		defer unlock()
+
 		existing, err := r.readExistingOrgPermissions(&plan)
 		if err != nil {
 			resp.Diagnostics.AddError("Error reading existing permissions", err.Error())
@@ -468,11 +799,16 @@ func (r *PermissionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	isWorkspaceLevel := !data.WorkspaceName.IsNull() && data.WorkspaceName.ValueString() != ""
+	if isWorkspaceLevelPermission(&data) {
+		workspaces, _, diags := r.workspaces(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	if isWorkspaceLevel {
-		// Send empty permissions for this workspace to remove all
-		statusCode, body, err := r.sendWorkspacePermissions(&data, []string{})
+		statusCode, body, err := r.updateWorkspacePermissions(&data, workspaces, func(_ string, existing []string) []string {
+			return removeFromSet(existing, data.Permission.ValueString())
+		})
 		if err != nil {
 			resp.Diagnostics.AddError("HTTP request failed", err.Error())
 			return
@@ -482,6 +818,9 @@ func (r *PermissionResource) Delete(ctx context.Context, req resource.DeleteRequ
 			return
 		}
 	} else {
+		unlock := lockPermissionScope(permissionScopeKey(&data, ""))
+		defer unlock()
+
 		existing, err := r.readExistingOrgPermissions(&data)
 		if err != nil {
 			resp.Diagnostics.AddError("Error reading existing permissions", err.Error())
@@ -502,6 +841,30 @@ func (r *PermissionResource) Delete(ctx context.Context, req resource.DeleteRequ
 	resp.State.RemoveResource(ctx)
 }
 
+// ModifyPlan resolves workspace_tags into selected_workspaces so the plan
+// shows every workspace the permission is applied to.
+func (r *PermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.provider == nil {
+		return
+	}
+
+	var plan PermissionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selected, diags := planWorkspaceSelection(ctx, r.provider, plan.OrganizationName, plan.WorkspaceName, plan.WorkspaceTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("selected_workspaces"), selected)
+	resp.Diagnostics.Append(diags...)
+}
+
 func (r *PermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resp.Diagnostics.AddError(
 		"Import not supported",
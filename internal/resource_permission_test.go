@@ -2,11 +2,14 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -123,6 +126,8 @@ func TestPermissionResource_Create(t *testing.T) {
 	plan.Permission = types.StringValue("read_workspaces")
 	plan.TeamID = types.StringNull()
 	plan.WorkspaceName = types.StringNull()
+	plan.WorkspaceTags = types.MapNull(types.StringType)
+	plan.SelectedWorkspaces = types.ListNull(types.StringType)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -167,6 +172,8 @@ func TestPermissionResource_Read(t *testing.T) {
 	state.Permission = types.StringValue("read_workspaces")
 	state.TeamID = types.StringNull()
 	state.WorkspaceName = types.StringNull()
+	state.WorkspaceTags = types.MapNull(types.StringType)
+	state.SelectedWorkspaces = types.ListNull(types.StringType)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -200,6 +207,8 @@ func TestPermissionResource_Delete(t *testing.T) {
 	state.Permission = types.StringValue("read_workspaces")
 	state.TeamID = types.StringNull()
 	state.WorkspaceName = types.StringNull()
+	state.WorkspaceTags = types.MapNull(types.StringType)
+	state.SelectedWorkspaces = types.ListNull(types.StringType)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -232,6 +241,8 @@ func TestPermissionResource_Create_NoUserOrTeam(t *testing.T) {
 	plan.UserEmail = types.StringNull()
 	plan.TeamID = types.StringNull()
 	plan.WorkspaceName = types.StringNull()
+	plan.WorkspaceTags = types.MapNull(types.StringType)
+	plan.SelectedWorkspaces = types.ListNull(types.StringType)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -251,3 +262,278 @@ func TestPermissionResource_Create_NoUserOrTeam(t *testing.T) {
 	require.True(t, response.Diagnostics.HasError())
 	assert.Contains(t, response.Diagnostics.Errors()[0].Summary(), "Invalid configuration")
 }
+
+// MockPermissionTagsRoundTripper serves a tagged workspace list and records
+// the workspace permission requests it receives.
+type MockPermissionTagsRoundTripper struct {
+	lastWorkspaceRequest *WorkspacePermissionRequest
+}
+
+func (m *MockPermissionTagsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusOK,
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	switch {
+	case req.Method == http.MethodGet && req.URL.Path == "/api/organizations/test-org/workspaces/":
+		resp.Body = io.NopCloser(strings.NewReader(`[
+			{"id": "1", "name": "net-prod", "tags": {"env": "prod", "tier": "network"}},
+			{"id": "2", "name": "app-prod", "tags": {"env": "prod"}},
+			{"id": "3", "name": "app-dev", "tags": {"env": "dev"}},
+			{"id": "4", "name": "untagged"}
+		]`))
+	case req.Method == http.MethodPost && req.URL.Path == "/api/permissions/test-org/workspaces/":
+		var body WorkspacePermissionRequest
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		m.lastWorkspaceRequest = &body
+		resp.StatusCode = http.StatusCreated
+		resp.Body = io.NopCloser(strings.NewReader(`{"message": "Permission created"}`))
+	case req.Method == http.MethodGet && req.URL.Path == "/api/permissions/test-org/workspaces/":
+		if req.URL.Query().Get("workspace") == "app-prod" {
+			resp.Body = io.NopCloser(strings.NewReader(`[
+				{"permission": "read_workspaces", "team": "team-1", "organization": "test-org", "workspace": "app-prod"},
+				{"permission": "write_workspaces", "team": "team-1", "organization": "test-org", "workspace": "app-prod"}
+			]`))
+		} else {
+			resp.Body = io.NopCloser(strings.NewReader(`[]`))
+		}
+	default:
+		resp.StatusCode = http.StatusNotFound
+		resp.Body = io.NopCloser(strings.NewReader(`{"error": "Not found"}`))
+	}
+	return resp, nil
+}
+
+func setupTestPermissionTagsResource(t *testing.T) (*PermissionResource, *MockPermissionTagsRoundTripper) {
+	t.Helper()
+	mock := &MockPermissionTagsRoundTripper{}
+	provider := &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}
+	return &PermissionResource{provider: provider}, mock
+}
+
+func tagPermissionModel(selected types.List) PermissionResourceModel {
+	return PermissionResourceModel{
+		ID:                 types.StringUnknown(),
+		OrganizationName:   types.StringValue("test-org"),
+		TeamID:             types.StringValue("team-1"),
+		UserEmail:          types.StringNull(),
+		Permission:         types.StringValue("read_workspaces"),
+		WorkspaceName:      types.StringNull(),
+		WorkspaceTags:      types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")}),
+		SelectedWorkspaces: selected,
+	}
+}
+
+func TestPermissionResource_ModifyPlan_WorkspaceTags(t *testing.T) {
+	r, _ := setupTestPermissionTagsResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	diags := plan.Set(ctx, tagPermissionModel(types.ListUnknown(types.StringType)))
+	require.Empty(t, diags)
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	var selected []string
+	diags = resp.Plan.GetAttribute(ctx, path.Root("selected_workspaces"), &selected)
+	require.Empty(t, diags)
+	assert.Equal(t, []string{"app-prod", "net-prod"}, selected)
+}
+
+func TestPermissionResource_ModifyPlan_WorkspaceTagsUnknownValue(t *testing.T) {
+	r, _ := setupTestPermissionTagsResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	// The tier is only known after apply; matching on env alone would
+	// select too many workspaces.
+	model := tagPermissionModel(types.ListUnknown(types.StringType))
+	model.WorkspaceTags = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"env":  types.StringValue("prod"),
+		"tier": types.StringUnknown(),
+	})
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	require.Empty(t, plan.Set(ctx, model))
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	var selected types.List
+	require.Empty(t, resp.Plan.GetAttribute(ctx, path.Root("selected_workspaces"), &selected))
+	assert.True(t, selected.IsUnknown())
+}
+
+func TestPermissionResource_Create_WorkspaceTags(t *testing.T) {
+	r, mock := setupTestPermissionTagsResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	request := resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: schemaResp.Schema},
+	}
+	diags := request.Plan.Set(ctx, tagPermissionModel(types.ListUnknown(types.StringType)))
+	require.Empty(t, diags)
+
+	response := resource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	r.Create(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	require.NotNil(t, mock.lastWorkspaceRequest)
+	// write_workspaces, granted on app-prod by other means, is kept.
+	assert.Equal(t, map[string][]string{
+		"app-prod": {"read_workspaces", "write_workspaces"},
+		"net-prod": {"read_workspaces"},
+	}, mock.lastWorkspaceRequest.Workspaces)
+
+	var state PermissionResourceModel
+	diags = response.State.Get(ctx, &state)
+	require.Empty(t, diags)
+	assert.Equal(t, "test-org:read_workspaces:team:team-1:tags:env=prod", state.ID.ValueString())
+	assert.Equal(t, 2, len(state.SelectedWorkspaces.Elements()))
+}
+
+func TestPermissionResource_Update_WorkspaceTags(t *testing.T) {
+	r, mock := setupTestPermissionTagsResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tagPermissionModel(types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("app-prod"), types.StringValue("old-prod"),
+	}))
+	state.ID = types.StringValue("test-org:read_workspaces:team:team-1:tags:env=prod")
+	plan := tagPermissionModel(types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("app-prod"), types.StringValue("net-prod"),
+	}))
+
+	request := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	require.Empty(t, request.Plan.Set(ctx, plan))
+	require.Empty(t, request.State.Set(ctx, state))
+
+	response := resource.UpdateResponse{State: request.State}
+	r.Update(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	require.NotNil(t, mock.lastWorkspaceRequest)
+	assert.Equal(t, map[string][]string{
+		"app-prod": {"write_workspaces", "read_workspaces"},
+		"net-prod": {"read_workspaces"},
+		"old-prod": {},
+	}, mock.lastWorkspaceRequest.Workspaces)
+}
+
+func TestPermissionResource_Delete_WorkspaceTags(t *testing.T) {
+	r, mock := setupTestPermissionTagsResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tagPermissionModel(types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("app-prod"), types.StringValue("net-prod"),
+	}))
+	state.ID = types.StringValue("test-org:read_workspaces:team:team-1:tags:env=prod")
+
+	request := resource.DeleteRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+	require.Empty(t, request.State.Set(ctx, state))
+
+	response := resource.DeleteResponse{State: request.State}
+	r.Delete(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	// Only read_workspaces is revoked; write_workspaces stays on app-prod.
+	require.NotNil(t, mock.lastWorkspaceRequest)
+	assert.Equal(t, map[string][]string{
+		"app-prod": {"write_workspaces"},
+		"net-prod": {},
+	}, mock.lastWorkspaceRequest.Workspaces)
+}
+
+func TestPermissionResource_Read_WorkspaceTags(t *testing.T) {
+	r, _ := setupTestPermissionTagsResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tagPermissionModel(types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("app-prod"), types.StringValue("net-prod"),
+	}))
+	state.ID = types.StringValue("test-org:read_workspaces:team:team-1:tags:env=prod")
+
+	request := resource.ReadRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+	require.Empty(t, request.State.Set(ctx, state))
+
+	response := resource.ReadResponse{State: request.State}
+	r.Read(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	// net-prod lost the permission out of band and is dropped from the
+	// selection, so the next plan re-applies it there.
+	var selected []string
+	diags := response.State.GetAttribute(ctx, path.Root("selected_workspaces"), &selected)
+	require.Empty(t, diags)
+	assert.Equal(t, []string{"app-prod"}, selected)
+}
+
+func TestPermissionResource_ValidateConfig(t *testing.T) {
+	r := &PermissionResource{}
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	validate := func(permission string, workspace types.String, tags types.Map) *resource.ValidateConfigResponse {
+		data := PermissionResourceModel{
+			ID:                 types.StringNull(),
+			OrganizationName:   types.StringValue("test-org"),
+			TeamID:             types.StringValue("team-1"),
+			UserEmail:          types.StringNull(),
+			Permission:         types.StringValue(permission),
+			WorkspaceName:      workspace,
+			WorkspaceTags:      tags,
+			SelectedWorkspaces: types.ListNull(types.StringType),
+		}
+		plan := tfsdk.Plan{Schema: schemaResp.Schema}
+		require.Empty(t, plan.Set(ctx, &data))
+		resp := &resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw}}, resp)
+		return resp
+	}
+
+	noTags := types.MapNull(types.StringType)
+	prodTags := types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")})
+
+	assert.False(t, validate("read_teams", types.StringNull(), noTags).Diagnostics.HasError())
+	assert.False(t, validate("write_workspaces", types.StringValue("prod"), noTags).Diagnostics.HasError())
+	assert.False(t, validate("write_workspaces", types.StringNull(), prodTags).Diagnostics.HasError())
+
+	resp := validate("read_teams", types.StringValue("prod"), noTags)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Invalid permission scope", resp.Diagnostics.Errors()[0].Summary())
+	assert.True(t, validate("write_organizations", types.StringUnknown(), noTags).Diagnostics.HasError())
+	assert.True(t, validate("write_organizations", types.StringNull(), prodTags).Diagnostics.HasError())
+}
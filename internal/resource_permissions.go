@@ -0,0 +1,283 @@
+package internal
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &PermissionsResource{}
+	_ resource.ResourceWithImportState    = &PermissionsResource{}
+	_ resource.ResourceWithValidateConfig = &PermissionsResource{}
+)
+
+func NewPermissionsResource() resource.Resource {
+	return &PermissionsResource{}
+}
+
+type PermissionsResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationName types.String `tfsdk:"organization_name"`
+	TeamID           types.String `tfsdk:"team_id"`
+	UserEmail        types.String `tfsdk:"user_email"`
+	WorkspaceName    types.String `tfsdk:"workspace_name"`
+	Permissions      types.Set    `tfsdk:"permissions"`
+}
+
+// PermissionsResource manages the complete set of codenames of one principal
+// at one scope. Unlike PermissionResource, codenames that are not declared
+// are revoked.
+type PermissionsResource struct {
+	provider *InfradotsProvider
+}
+
+func (r *PermissionsResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "infradots_permissions"
+}
+
+func (r *PermissionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritative set of permissions of a user or team, for the organization or a single workspace. Permissions that are not declared are removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Composite ID (organization:user_or_team[:workspace]).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the team whose permissions are managed. Exactly one of team_id and user_email must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_email")),
+				},
+			},
+			"user_email": schema.StringAttribute{
+				Description: "The email of the user whose permissions are managed. Exactly one of team_id and user_email must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_name": schema.StringAttribute{
+				Description: "The workspace whose permissions are managed. If not set, the organization-level permissions are managed.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				Description: "The complete set of permission codenames the principal holds at this scope. An empty set revokes everything.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(knownPermissionCodenames()...)),
+				},
+			},
+		},
+	}
+}
+
+func (r *PermissionsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		if provider, ok := req.ProviderData.(*InfradotsProvider); ok {
+			r.provider = provider
+		}
+	}
+}
+
+// ValidateConfig rejects codenames that cannot be granted at the configured
+// scope.
+func (r *PermissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PermissionsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Permissions.IsNull() || data.Permissions.IsUnknown() {
+		return
+	}
+
+	workspaceScoped := data.WorkspaceName.IsUnknown() || data.WorkspaceName.ValueString() != ""
+	for _, v := range data.Permissions.Elements() {
+		codename, ok := v.(types.String)
+		if !ok || codename.IsNull() || codename.IsUnknown() {
+			continue
+		}
+		if msg := permissionScopeError(codename.ValueString(), workspaceScoped); msg != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("permissions"), "Invalid permission scope", msg)
+		}
+	}
+}
+
+func (r *PermissionsResource) computeID(data *PermissionsResourceModel) string {
+	parts := []string{data.OrganizationName.ValueString()}
+	if !data.UserEmail.IsNull() && data.UserEmail.ValueString() != "" {
+		parts = append(parts, "user:"+data.UserEmail.ValueString())
+	} else {
+		parts = append(parts, "team:"+data.TeamID.ValueString())
+	}
+	if !data.WorkspaceName.IsNull() && data.WorkspaceName.ValueString() != "" {
+		parts = append(parts, data.WorkspaceName.ValueString())
+	}
+	return strings.Join(parts, ":")
+}
+
+// read stores the codenames the principal currently holds in data.
+func (r *PermissionsResource) read(ctx context.Context, data *PermissionsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	perms, target := permissionScope(r.provider, data.OrganizationName, data.TeamID, data.UserEmail, data.WorkspaceName)
+	granted, err := perms.readScopePermissions(target)
+	if err != nil {
+		diags.AddError("Error reading permissions", err.Error())
+		return diags
+	}
+	if granted == nil {
+		granted = []string{}
+	}
+	sort.Strings(granted)
+
+	data.Permissions, diags = types.SetValueFrom(ctx, types.StringType, granted)
+	data.ID = types.StringValue(r.computeID(data))
+	return diags
+}
+
+// write replaces the principal's codenames with the declared set.
+func (r *PermissionsResource) write(ctx context.Context, data *PermissionsResourceModel) diag.Diagnostics {
+	declared := []string{}
+	diags := data.Permissions.ElementsAs(ctx, &declared, false)
+	if diags.HasError() {
+		return diags
+	}
+	sort.Strings(declared)
+
+	perms, target := permissionScope(r.provider, data.OrganizationName, data.TeamID, data.UserEmail, data.WorkspaceName)
+	if err := perms.setScopePermissions(target, declared); err != nil {
+		diags.AddError("Setting permissions failed", err.Error())
+		return diags
+	}
+
+	data.ID = types.StringValue(r.computeID(data))
+	return diags
+}
+
+func (r *PermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read reports every codename the principal holds, so permissions granted
+// outside Terraform show up as drift and are removed on the next apply.
+func (r *PermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete revokes every permission of the principal at this scope.
+func (r *PermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	perms, target := permissionScope(r.provider, data.OrganizationName, data.TeamID, data.UserEmail, data.WorkspaceName)
+	if err := perms.setScopePermissions(target, nil); err != nil {
+		resp.Diagnostics.AddError("Removing permissions failed", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *PermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Format: "organization_name:user:email[:workspace_name]" or
+	// "organization_name:team:team_id[:workspace_name]"
+	parts := strings.Split(req.ID, ":")
+	if len(parts) < 3 || len(parts) > 4 || parts[0] == "" || parts[2] == "" ||
+		(parts[1] != "user" && parts[1] != "team") || (len(parts) == 4 && parts[3] == "") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Import ID must be in the format 'organization_name:user:email[:workspace_name]' or 'organization_name:team:team_id[:workspace_name]'",
+		)
+		return
+	}
+
+	data := PermissionsResourceModel{
+		OrganizationName: types.StringValue(parts[0]),
+		TeamID:           types.StringNull(),
+		UserEmail:        types.StringNull(),
+		WorkspaceName:    types.StringNull(),
+	}
+	if parts[1] == "user" {
+		data.UserEmail = types.StringValue(parts[2])
+	} else {
+		data.TeamID = types.StringValue(parts[2])
+	}
+	if len(parts) == 4 {
+		data.WorkspaceName = types.StringValue(parts[3])
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The permission endpoints are served by MockRoleRoundTripper.
+func setupTestPermissionsResource(t *testing.T, permissions map[string][]string) (*PermissionsResource, *MockRoleRoundTripper) {
+	t.Helper()
+	mock := &MockRoleRoundTripper{permissions: permissions}
+	provider := &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}
+	return &PermissionsResource{provider: provider}, mock
+}
+
+func stringSet(values ...string) types.Set {
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elems[i] = types.StringValue(v)
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
+
+func TestPermissionsResource_Metadata(t *testing.T) {
+	r := NewPermissionsResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{}, resp)
+	assert.Equal(t, "infradots_permissions", resp.TypeName)
+}
+
+func TestPermissionsResource_Lifecycle(t *testing.T) {
+	r, mock := setupTestPermissionsResource(t, map[string][]string{
+		"jane@example.com@prod": {"read_workspaces", "write_workspaces"},
+	})
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := PermissionsResourceModel{
+		ID:               types.StringUnknown(),
+		OrganizationName: types.StringValue("test-org"),
+		TeamID:           types.StringNull(),
+		UserEmail:        types.StringValue("jane@example.com"),
+		WorkspaceName:    types.StringValue("prod"),
+		Permissions:      stringSet("read_workspaces"),
+	}
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, createReq.Plan.Set(ctx, &plan))
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, createReq, &createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	// Undeclared permissions are removed.
+	assert.Equal(t, []string{"read_workspaces"}, mock.permissions["jane@example.com@prod"])
+
+	var state PermissionsResourceModel
+	require.Empty(t, createResp.State.Get(ctx, &state))
+	assert.Equal(t, "test-org:user:jane@example.com:prod", state.ID.ValueString())
+
+	// A permission granted outside Terraform shows up on refresh.
+	mock.permissions["jane@example.com@prod"] = []string{"write_workspaces", "read_workspaces"}
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	require.Empty(t, readResp.State.Get(ctx, &state))
+	assert.True(t, state.Permissions.Equal(stringSet("read_workspaces", "write_workspaces")))
+
+	deleteResp := resource.DeleteResponse{State: readResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, &deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+	assert.Empty(t, mock.permissions["jane@example.com@prod"])
+}
+
+func TestPermissionsResource_ImportState(t *testing.T) {
+	r, _ := setupTestPermissionsResource(t, map[string][]string{"team-1": {"read_teams", "read_organizations"}})
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	response := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "test-org:team:team-1"}, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+	var state PermissionsResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "team-1", state.TeamID.ValueString())
+	assert.True(t, state.UserEmail.IsNull())
+	assert.True(t, state.WorkspaceName.IsNull())
+	assert.True(t, state.Permissions.Equal(stringSet("read_organizations", "read_teams")))
+
+	for _, id := range []string{"test-org", "test-org:group:x", "test-org:team:", "test-org:team:team-1:"} {
+		response := resource.ImportStateResponse{}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, &response)
+		require.True(t, response.Diagnostics.HasError(), id)
+		assert.Contains(t, response.Diagnostics.Errors()[0].Summary(), "Invalid import ID format")
+	}
+}
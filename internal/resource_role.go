@@ -0,0 +1,303 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &RoleResource{}
+	_ resource.ResourceWithImportState = &RoleResource{}
+)
+
+func NewRoleResource() resource.Resource {
+	return &RoleResource{}
+}
+
+type RoleResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationName types.String `tfsdk:"organization_name"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Permissions      types.List   `tfsdk:"permissions"`
+}
+
+type RoleAPIResponse struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+type RoleRequest struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+type RoleResource struct {
+	provider *InfradotsProvider
+}
+
+func (r *RoleResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "infradots_role"
+}
+
+func (r *RoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A named set of permission codenames that can be granted to users and teams with infradots_role_assignment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The role unique ID (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization this role belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the role.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the role.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"permissions": schema.ListAttribute{
+				Description: "The permission codenames granted by the role (e.g., read_workspaces, write_workspaces).",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(knownPermissionCodenames()...)),
+				},
+			},
+		},
+	}
+}
+
+func (r *RoleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		if provider, ok := req.ProviderData.(*InfradotsProvider); ok {
+			r.provider = provider
+		}
+	}
+}
+
+// fetchRole returns a role of the organization along with the response
+// status code, so callers can tell a deleted role (404) from other failures.
+func fetchRole(p *InfradotsProvider, organizationName, roleID string) (*RoleAPIResponse, int, error) {
+	url := fmt.Sprintf("https://%s/api/organizations/%s/roles/%s/", p.host, organizationName, roleID)
+
+	reqHttp, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(reqHttp)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, httpResp.StatusCode, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, httpResp.StatusCode, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var role RoleAPIResponse
+	if err := json.Unmarshal(respBody, &role); err != nil {
+		return nil, httpResp.StatusCode, err
+	}
+	return &role, httpResp.StatusCode, nil
+}
+
+func mapRoleResponseToModel(ctx context.Context, data *RoleResourceModel, role RoleAPIResponse) {
+	data.ID = types.StringValue(role.ID)
+	data.Name = types.StringValue(role.Name)
+	data.Description = types.StringValue(role.Description)
+	if role.Permissions == nil {
+		role.Permissions = []string{}
+	}
+	data.Permissions, _ = types.ListValueFrom(ctx, types.StringType, role.Permissions)
+}
+
+// save sends the role with the given method and stores the response in data.
+func (r *RoleResource) save(ctx context.Context, method, url string, data *RoleResourceModel, wantStatus int) error {
+	body := RoleRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	}
+	if diags := data.Permissions.ElementsAs(ctx, &body.Permissions, false); diags.HasError() {
+		return fmt.Errorf("invalid permissions: %v", diags)
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	reqHttp, err := http.NewRequest(method, url, strings.NewReader(string(reqBody)))
+	if err != nil {
+		return err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
+	reqHttp.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := r.provider.client.Do(reqHttp)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return err
+	}
+	if httpResp.StatusCode != wantStatus {
+		return fmt.Errorf("status: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var role RoleAPIResponse
+	if err := json.Unmarshal(respBody, &role); err != nil {
+		return err
+	}
+	mapRoleResponseToModel(ctx, data, role)
+	return nil
+}
+
+func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := fmt.Sprintf("https://%s/api/organizations/%s/roles/", r.provider.host, data.OrganizationName.ValueString())
+	if err := r.save(ctx, http.MethodPost, url, &data, http.StatusCreated); err != nil {
+		resp.Diagnostics.AddError("Failed to create role", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Role Resource Created", map[string]any{"success": true})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, statusCode, err := fetchRole(r.provider, data.OrganizationName.ValueString(), data.ID.ValueString())
+	if statusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Read failed", err.Error())
+		return
+	}
+
+	mapRoleResponseToModel(ctx, &data, *role)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := fmt.Sprintf("https://%s/api/organizations/%s/roles/%s/", r.provider.host, data.OrganizationName.ValueString(), data.ID.ValueString())
+	if err := r.save(ctx, http.MethodPut, url, &data, http.StatusOK); err != nil {
+		resp.Diagnostics.AddError("Failed to update role", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := fmt.Sprintf("https://%s/api/organizations/%s/roles/%s/", r.provider.host, data.OrganizationName.ValueString(), data.ID.ValueString())
+	reqHttp, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
+
+	httpResp, err := r.provider.client.Do(reqHttp)
+	if err != nil {
+		resp.Diagnostics.AddError("HTTP request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 204 && httpResp.StatusCode != 200 && httpResp.StatusCode != 404 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		resp.Diagnostics.AddError("Delete failed", fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)))
+	}
+}
+
+func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Format: "organization_name:role_id"
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Import ID must be in the format 'organization_name:role_id'",
+		)
+		return
+	}
+
+	role, _, err := fetchRole(r.provider, parts[0], parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing role", err.Error())
+		return
+	}
+
+	var data RoleResourceModel
+	data.OrganizationName = types.StringValue(parts[0])
+	mapRoleResponseToModel(ctx, &data, *role)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,369 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource               = &RoleAssignmentResource{}
+	_ resource.ResourceWithModifyPlan = &RoleAssignmentResource{}
+)
+
+func NewRoleAssignmentResource() resource.Resource {
+	return &RoleAssignmentResource{}
+}
+
+type RoleAssignmentResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationName types.String `tfsdk:"organization_name"`
+	RoleID           types.String `tfsdk:"role_id"`
+	TeamID           types.String `tfsdk:"team_id"`
+	UserEmail        types.String `tfsdk:"user_email"`
+	WorkspaceName    types.String `tfsdk:"workspace_name"`
+	Permissions      types.List   `tfsdk:"permissions"`
+	AddedPermissions types.List   `tfsdk:"added_permissions"`
+}
+
+// RoleAssignmentResource grants the permissions of an infradots_role through
+// the same /api/permissions/ endpoints as PermissionResource. The role is
+// not known to the permissions API; the assignment expands it into codenames.
+// The API does not record why a principal holds a codename, so the
+// assignment keeps the codenames it added itself in added_permissions and
+// only ever revokes those; codenames the principal already held stay.
+type RoleAssignmentResource struct {
+	provider *InfradotsProvider
+}
+
+func (r *RoleAssignmentResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "infradots_role_assignment"
+}
+
+func (r *RoleAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants the permissions of a role to a user or team, for the organization or a single workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Composite ID for this assignment (organization:role_id:user_or_team[:workspace]).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description: "The ID of the infradots_role to grant.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the team to grant the role to. Exactly one of team_id and user_email must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_email")),
+				},
+			},
+			"user_email": schema.StringAttribute{
+				Description: "The email of the user to grant the role to. Exactly one of team_id and user_email must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_name": schema.StringAttribute{
+				Description: "The workspace to grant the role on. If not set, the role is granted at organization level.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.ListAttribute{
+				Description: "The permission codenames granted through the role, resolved from the role at plan time.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"added_permissions": schema.ListAttribute{
+				Description: "The codenames of permissions that the principal did not hold before the assignment granted them. Only these are revoked when the assignment is removed or the role drops them.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *RoleAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		if provider, ok := req.ProviderData.(*InfradotsProvider); ok {
+			r.provider = provider
+		}
+	}
+}
+
+func (r *RoleAssignmentResource) computeID(data *RoleAssignmentResourceModel) string {
+	parts := []string{data.OrganizationName.ValueString(), data.RoleID.ValueString()}
+	if !data.UserEmail.IsNull() && data.UserEmail.ValueString() != "" {
+		parts = append(parts, "user:"+data.UserEmail.ValueString())
+	} else {
+		parts = append(parts, "team:"+data.TeamID.ValueString())
+	}
+	if !data.WorkspaceName.IsNull() && data.WorkspaceName.ValueString() != "" {
+		parts = append(parts, data.WorkspaceName.ValueString())
+	}
+	return strings.Join(parts, ":")
+}
+
+// grantedPermissions returns every codename the principal currently holds at
+// the assignment's scope, whether granted by this role or otherwise.
+func (r *RoleAssignmentResource) grantedPermissions(data *RoleAssignmentResourceModel) ([]string, error) {
+	perms, target := permissionScope(r.provider, data.OrganizationName, data.TeamID, data.UserEmail, data.WorkspaceName)
+	return perms.readScopePermissions(target)
+}
+
+// apply grants grant to the principal at the assignment's scope and revokes
+// the codenames in added that grant no longer contains, leaving any other
+// permission untouched. added holds the codenames the assignment added
+// earlier; it returns those it has added now.
+func (r *RoleAssignmentResource) apply(data *RoleAssignmentResourceModel, grant, added []string) ([]string, error) {
+	perms, target := permissionScope(r.provider, data.OrganizationName, data.TeamID, data.UserEmail, data.WorkspaceName)
+	unlock := lockPermissionScope(permissionScopeKey(target, data.WorkspaceName.ValueString()))
+	defer unlock()
+
+	existing, err := perms.readScopePermissions(target)
+	if err != nil {
+		return nil, err
+	}
+
+	nowAdded := []string{}
+	for _, p := range grant {
+		if slices.Contains(added, p) || !slices.Contains(existing, p) {
+			nowAdded = append(nowAdded, p)
+		}
+	}
+	updated := stringSetDifference(existing, stringSetDifference(added, grant))
+	for _, p := range grant {
+		updated = addToSet(updated, p)
+	}
+
+	if err := perms.setScopePermissions(target, updated); err != nil {
+		return nil, err
+	}
+	return nowAdded, nil
+}
+
+// rolePermissions fetches the codenames of the assignment's role.
+func (r *RoleAssignmentResource) rolePermissions(data *RoleAssignmentResourceModel) ([]string, int, error) {
+	role, statusCode, err := fetchRole(r.provider, data.OrganizationName.ValueString(), data.RoleID.ValueString())
+	if err != nil {
+		return nil, statusCode, err
+	}
+	if role.Permissions == nil {
+		return []string{}, statusCode, nil
+	}
+	return role.Permissions, statusCode, nil
+}
+
+// plannedPermissions returns the codenames to grant. They are fixed at plan
+// time when the role already exists; a role created in the same apply is
+// resolved now.
+func (r *RoleAssignmentResource) plannedPermissions(ctx context.Context, data *RoleAssignmentResourceModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.Permissions.IsUnknown() && !data.Permissions.IsNull() {
+		perms := []string{}
+		diags.Append(data.Permissions.ElementsAs(ctx, &perms, false)...)
+		return perms, diags
+	}
+
+	perms, _, err := r.rolePermissions(data)
+	if err != nil {
+		diags.AddAttributeError(path.Root("role_id"), "Error reading role", err.Error())
+	}
+	return perms, diags
+}
+
+func (r *RoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grant, diags := r.plannedPermissions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added, err := r.apply(&data, grant, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Create role assignment failed", err.Error())
+		return
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, grant)
+	resp.Diagnostics.Append(diags...)
+	data.Permissions = list
+	addedList, diags := types.ListValueFrom(ctx, types.StringType, added)
+	resp.Diagnostics.Append(diags...)
+	data.AddedPermissions = addedList
+	data.ID = types.StringValue(r.computeID(&data))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps only the role's codenames that the principal still holds, so a
+// permission revoked outside Terraform shows up as a diff and is re-granted.
+func (r *RoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var assigned []string
+	if !data.Permissions.IsNull() && !data.Permissions.IsUnknown() {
+		resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &assigned, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	granted, err := r.grantedPermissions(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading permissions", err.Error())
+		return
+	}
+
+	held := stringSetDifference(assigned, stringSetDifference(assigned, granted))
+	if held == nil {
+		held = []string{}
+	}
+	list, diags := types.ListValueFrom(ctx, types.StringType, held)
+	resp.Diagnostics.Append(diags...)
+	data.Permissions = list
+	data.ID = types.StringValue(r.computeID(&data))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update reconciles the granted codenames with the role's current ones. It
+// only runs when the role changed or a permission was revoked elsewhere;
+// every configurable attribute forces replacement.
+func (r *RoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grant, diags := r.plannedPermissions(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous []string
+	if !state.AddedPermissions.IsNull() && !state.AddedPermissions.IsUnknown() {
+		resp.Diagnostics.Append(state.AddedPermissions.ElementsAs(ctx, &previous, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	added, err := r.apply(&plan, grant, previous)
+	if err != nil {
+		resp.Diagnostics.AddError("Update role assignment failed", err.Error())
+		return
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, grant)
+	resp.Diagnostics.Append(diags...)
+	plan.Permissions = list
+	addedList, diags := types.ListValueFrom(ctx, types.StringType, added)
+	resp.Diagnostics.Append(diags...)
+	plan.AddedPermissions = addedList
+	plan.ID = types.StringValue(r.computeID(&plan))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State written before added_permissions existed has none, so nothing
+	// is revoked.
+	var added []string
+	if !data.AddedPermissions.IsNull() && !data.AddedPermissions.IsUnknown() {
+		resp.Diagnostics.Append(data.AddedPermissions.ElementsAs(ctx, &added, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if _, err := r.apply(&data, nil, added); err != nil {
+		resp.Diagnostics.AddError("Delete role assignment failed", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ModifyPlan resolves the role into the codenames it grants, so changes to
+// the role show up in the plan of every assignment. The role is read as it is
+// before apply: when a role and its assignments change together, the
+// assignments catch up on the next apply.
+func (r *RoleAssignmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.provider == nil {
+		return
+	}
+
+	var plan RoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.OrganizationName.IsUnknown() || plan.RoleID.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permissions"), types.ListUnknown(types.StringType))...)
+		return
+	}
+
+	perms, statusCode, err := r.rolePermissions(&plan)
+	if err != nil {
+		summary := "Error reading role"
+		if statusCode == http.StatusNotFound {
+			summary = "Role not found"
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("role_id"), summary, err.Error())
+		return
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, perms)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permissions"), list)...)
+}
@@ -0,0 +1,264 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRoleRoundTripper serves a single role and keeps the permissions of each
+// principal in memory, keyed by "principal" or "principal@workspace".
+type MockRoleRoundTripper struct {
+	role        RoleAPIResponse
+	permissions map[string][]string
+	posts       int
+}
+
+func (m *MockRoleRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusOK,
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	principal := req.URL.Query().Get("user") + req.URL.Query().Get("team")
+	respond := func(status int, v any) (*http.Response, error) {
+		body, _ := json.Marshal(v)
+		resp.StatusCode = status
+		resp.Body = io.NopCloser(strings.NewReader(string(body)))
+		return resp, nil
+	}
+	listPermissions := func(key string) []PermissionAPIResponse {
+		out := []PermissionAPIResponse{}
+		for _, p := range m.permissions[key] {
+			out = append(out, PermissionAPIResponse{Permission: p, Organization: "test-org"})
+		}
+		return out
+	}
+
+	switch {
+	case req.Method == http.MethodPost && req.URL.Path == "/api/organizations/test-org/roles/":
+		var body RoleRequest
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		m.role = RoleAPIResponse{ID: "role-1", Name: body.Name, Description: body.Description, Permissions: body.Permissions}
+		return respond(http.StatusCreated, m.role)
+	case req.Method == http.MethodGet && req.URL.Path == "/api/organizations/test-org/roles/role-1/":
+		return respond(http.StatusOK, m.role)
+	case req.Method == http.MethodGet && req.URL.Path == "/api/permissions/test-org/":
+		return respond(http.StatusOK, listPermissions(principal))
+	case req.Method == http.MethodGet && req.URL.Path == "/api/permissions/test-org/workspaces/":
+		return respond(http.StatusOK, listPermissions(principal+"@"+req.URL.Query().Get("workspace")))
+	case req.Method == http.MethodPost && req.URL.Path == "/api/permissions/test-org/":
+		m.posts++
+		var body OrgPermissionRequest
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		m.permissions[body.User+body.Team] = body.AssignedPermissions
+		return respond(http.StatusOK, map[string]string{})
+	case req.Method == http.MethodPost && req.URL.Path == "/api/permissions/test-org/workspaces/":
+		m.posts++
+		var body WorkspacePermissionRequest
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		for ws, perms := range body.Workspaces {
+			m.permissions[body.User+body.Team+"@"+ws] = perms
+		}
+		return respond(http.StatusOK, map[string]string{})
+	}
+	return respond(http.StatusNotFound, map[string]string{"error": "Not found"})
+}
+
+func setupTestRoleResources(t *testing.T, permissions map[string][]string) (*RoleResource, *RoleAssignmentResource, *MockRoleRoundTripper) {
+	t.Helper()
+	mock := &MockRoleRoundTripper{
+		role:        RoleAPIResponse{ID: "role-1", Name: "deployer", Permissions: []string{"read_workspaces", "write_workspaces"}},
+		permissions: permissions,
+	}
+	provider := &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}
+	return &RoleResource{provider: provider}, &RoleAssignmentResource{provider: provider}, mock
+}
+
+func TestRoleResource_Create(t *testing.T) {
+	r, _, mock := setupTestRoleResources(t, map[string][]string{})
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := RoleResourceModel{
+		ID:               types.StringUnknown(),
+		OrganizationName: types.StringValue("test-org"),
+		Name:             types.StringValue("auditor"),
+		Description:      types.StringValue(""),
+		Permissions:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("read_workspaces")}),
+	}
+	request := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, request.Plan.Set(ctx, &plan))
+
+	response := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+	var state RoleResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "role-1", state.ID.ValueString())
+	assert.Equal(t, []string{"read_workspaces"}, mock.role.Permissions)
+}
+
+func newTestRoleAssignment(workspace types.String, perms types.List) RoleAssignmentResourceModel {
+	return RoleAssignmentResourceModel{
+		ID:               types.StringUnknown(),
+		OrganizationName: types.StringValue("test-org"),
+		RoleID:           types.StringValue("role-1"),
+		TeamID:           types.StringValue("team-1"),
+		UserEmail:        types.StringNull(),
+		WorkspaceName:    workspace,
+		Permissions:      perms,
+		AddedPermissions: types.ListUnknown(types.StringType),
+	}
+}
+
+func TestRoleAssignmentResource_ModifyPlan(t *testing.T) {
+	_, r, _ := setupTestRoleResources(t, map[string][]string{})
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := newTestRoleAssignment(types.StringNull(), types.ListUnknown(types.StringType))
+	req := resource.ModifyPlanRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, req.Plan.Set(ctx, &plan))
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var planned RoleAssignmentResourceModel
+	require.Empty(t, resp.Plan.Get(ctx, &planned))
+	var perms []string
+	require.Empty(t, planned.Permissions.ElementsAs(ctx, &perms, false))
+	assert.Equal(t, []string{"read_workspaces", "write_workspaces"}, perms)
+}
+
+func TestRoleAssignmentResource_CreateDelete_KeepsOtherPermissions(t *testing.T) {
+	_, r, mock := setupTestRoleResources(t, map[string][]string{"team-1": {"read_teams"}})
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	// The role is created in the same apply, so permissions are unknown.
+	plan := newTestRoleAssignment(types.StringNull(), types.ListUnknown(types.StringType))
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, createReq.Plan.Set(ctx, &plan))
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, createReq, &createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	assert.Equal(t, []string{"read_teams", "read_workspaces", "write_workspaces"}, mock.permissions["team-1"])
+
+	var state RoleAssignmentResourceModel
+	require.Empty(t, createResp.State.Get(ctx, &state))
+	assert.Equal(t, "test-org:role-1:team:team-1", state.ID.ValueString())
+
+	deleteResp := resource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: createResp.State}, &deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+	assert.Equal(t, []string{"read_teams"}, mock.permissions["team-1"])
+}
+
+func TestRoleAssignmentResource_Workspace_ReadAndUpdate(t *testing.T) {
+	_, r, mock := setupTestRoleResources(t, map[string][]string{"team-1@prod": {"read_workspaces"}})
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	// write_workspaces was revoked outside Terraform.
+	prior := newTestRoleAssignment(types.StringValue("prod"),
+		types.ListValueMust(types.StringType, []attr.Value{types.StringValue("read_workspaces"), types.StringValue("write_workspaces")}))
+	prior.ID = types.StringValue("test-org:role-1:team:team-1:prod")
+	prior.AddedPermissions = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("write_workspaces")})
+	readReq := resource.ReadRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+	require.Empty(t, readReq.State.Set(ctx, &prior))
+	readResp := resource.ReadResponse{State: readReq.State}
+	r.Read(ctx, readReq, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+
+	var state RoleAssignmentResourceModel
+	require.Empty(t, readResp.State.Get(ctx, &state))
+	var held []string
+	require.Empty(t, state.Permissions.ElementsAs(ctx, &held, false))
+	assert.Equal(t, []string{"read_workspaces"}, held)
+
+	// The plan restores the role's permissions, re-granting write_workspaces.
+	plan := prior
+	updateReq := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}, State: readResp.State}
+	require.Empty(t, updateReq.Plan.Set(ctx, &plan))
+	updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, updateReq, &updateResp)
+	require.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics)
+	assert.Equal(t, []string{"read_workspaces", "write_workspaces"}, mock.permissions["team-1@prod"])
+}
+
+func TestRoleAssignmentResource_Delete_KeepsPermissionsHeldBefore(t *testing.T) {
+	_, r, mock := setupTestRoleResources(t, map[string][]string{})
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	create := func(roleID string, perms ...string) tfsdk.State {
+		values := []attr.Value{}
+		for _, p := range perms {
+			values = append(values, types.StringValue(p))
+		}
+		plan := newTestRoleAssignment(types.StringNull(), types.ListValueMust(types.StringType, values))
+		plan.RoleID = types.StringValue(roleID)
+		createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+		require.Empty(t, createReq.Plan.Set(ctx, &plan))
+		createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Create(ctx, createReq, &createResp)
+		require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+		return createResp.State
+	}
+	destroy := func(r *RoleAssignmentResource, state tfsdk.State) {
+		deleteResp := resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &deleteResp)
+		require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+	}
+
+	reader := create("role-2", "read_workspaces")
+	deployer := create("role-1", "read_workspaces", "write_workspaces")
+	assert.Equal(t, []string{"read_workspaces", "write_workspaces"}, mock.permissions["team-1"])
+
+	var state RoleAssignmentResourceModel
+	require.Empty(t, deployer.Get(ctx, &state))
+	var added []string
+	require.Empty(t, state.AddedPermissions.ElementsAs(ctx, &added, false))
+	assert.Equal(t, []string{"write_workspaces"}, added)
+
+	// A new provider process only has the state to go on; read_workspaces
+	// was held before the deployer role and stays.
+	fresh := &RoleAssignmentResource{provider: &InfradotsProvider{
+		host:   r.provider.host,
+		token:  r.provider.token,
+		client: r.provider.client,
+	}}
+	destroy(fresh, deployer)
+	assert.Equal(t, []string{"read_workspaces"}, mock.permissions["team-1"])
+
+	destroy(r, reader)
+	assert.Empty(t, mock.permissions["team-1"])
+}
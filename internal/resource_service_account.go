@@ -9,11 +9,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -33,6 +37,9 @@ type ServiceAccountResourceModel struct {
 	Scopes      types.List   `tfsdk:"scopes"`
 	IsActive    types.Bool   `tfsdk:"is_active"`
 	CreatedAt   types.String `tfsdk:"created_at"`
+	// OrganizationNames restricts the account to these organizations. Null
+	// means the account is not restricted.
+	OrganizationNames types.Set `tfsdk:"organization_names"`
 }
 
 type ServiceAccountAPIResponse struct {
@@ -42,6 +49,8 @@ type ServiceAccountAPIResponse struct {
 	Scopes      []string  `json:"scopes"`
 	IsActive    bool      `json:"is_active"`
 	CreatedAt   time.Time `json:"created_at"`
+
+	OrganizationNames []string `json:"organization_names"`
 }
 
 type ServiceAccountCreateRequest struct {
@@ -49,6 +58,8 @@ type ServiceAccountCreateRequest struct {
 	Description string   `json:"description,omitempty"`
 	Scopes      []string `json:"scopes,omitempty"`
 	IsActive    bool     `json:"is_active"`
+
+	OrganizationNames []string `json:"organization_names,omitempty"`
 }
 
 type ServiceAccountUpdateRequest struct {
@@ -56,6 +67,10 @@ type ServiceAccountUpdateRequest struct {
 	Description string   `json:"description,omitempty"`
 	Scopes      []string `json:"scopes,omitempty"`
 	IsActive    *bool    `json:"is_active,omitempty"`
+
+	// OrganizationNames is a pointer so that lifting the restriction can be
+	// sent as an empty list.
+	OrganizationNames *[]string `json:"organization_names,omitempty"`
 }
 
 type ServiceAccountResource struct {
@@ -87,10 +102,23 @@ func (r *ServiceAccountResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:    true,
 			},
 			"scopes": schema.ListAttribute{
-				Description: "List of scopes assigned to the service account.",
+				Description: "List of scopes assigned to the service account. Scopes are permission codenames (e.g., read_workspaces, write_workspaces).",
 				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(knownPermissionCodenames()...)),
+				},
+			},
+			"organization_names": schema.SetAttribute{
+				Description: "Restricts the service account to these organizations. If not set, the service account can be used in every organization.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
 			},
 			"is_active": schema.BoolAttribute{
 				Description: "Whether the service account is active.",
@@ -122,6 +150,11 @@ func serviceAccountToModel(ctx context.Context, data *ServiceAccountResourceMode
 	data.CreatedAt = types.StringValue(sa.CreatedAt.Format(time.RFC3339))
 	scopes, _ := types.ListValueFrom(ctx, types.StringType, sa.Scopes)
 	data.Scopes = scopes
+	if len(sa.OrganizationNames) == 0 {
+		data.OrganizationNames = types.SetNull(types.StringType)
+	} else {
+		data.OrganizationNames, _ = types.SetValueFrom(ctx, types.StringType, sa.OrganizationNames)
+	}
 }
 
 func (r *ServiceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -147,6 +180,12 @@ func (r *ServiceAccountResource) Create(ctx context.Context, req resource.Create
 		}
 		createReq.Scopes = scopes
 	}
+	if !data.OrganizationNames.IsNull() && !data.OrganizationNames.IsUnknown() {
+		resp.Diagnostics.Append(data.OrganizationNames.ElementsAs(ctx, &createReq.OrganizationNames, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	reqBody, err := json.Marshal(createReq)
 	if err != nil {
@@ -278,6 +317,16 @@ func (r *ServiceAccountResource) Update(ctx context.Context, req resource.Update
 		}
 		updateReq.Scopes = scopes
 	}
+	if !plan.OrganizationNames.Equal(state.OrganizationNames) {
+		orgs := []string{}
+		if !plan.OrganizationNames.IsNull() {
+			resp.Diagnostics.Append(plan.OrganizationNames.ElementsAs(ctx, &orgs, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		updateReq.OrganizationNames = &orgs
+	}
 
 	reqBody, err := json.Marshal(updateReq)
 	if err != nil {
@@ -408,3 +457,33 @@ func (r *ServiceAccountResource) ImportState(ctx context.Context, req resource.I
 	serviceAccountToModel(ctx, &data, sa)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// listServiceAccounts returns every service account.
+func listServiceAccounts(p *InfradotsProvider) ([]ServiceAccountAPIResponse, error) {
+	url := fmt.Sprintf("https://%s/api/admin/service-accounts/", p.host)
+	httpReq, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var accounts []ServiceAccountAPIResponse
+	if err := json.Unmarshal(respBody, &accounts); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
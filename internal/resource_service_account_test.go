@@ -0,0 +1,171 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockServiceAccountRoundTripper serves the admin service account API.
+// accounts and tokens (keyed by service account ID) are raw JSON documents;
+// the body of the last create or update request is kept in lastBody.
+type MockServiceAccountRoundTripper struct {
+	accounts string
+	tokens   map[string]string
+	lastBody map[string]interface{}
+}
+
+func (m *MockServiceAccountRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusOK,
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	if req.Body != nil {
+		body, _ := io.ReadAll(req.Body)
+		m.lastBody = map[string]interface{}{}
+		_ = json.Unmarshal(body, &m.lastBody)
+	}
+
+	const prefix = "/api/admin/service-accounts/"
+	p := req.URL.Path
+	switch {
+	case req.Method == http.MethodPost && p == prefix:
+		resp.StatusCode = http.StatusCreated
+		resp.Body = io.NopCloser(strings.NewReader(m.echo("sa-1")))
+	case req.Method == http.MethodPatch && p == prefix+"sa-1/":
+		resp.Body = io.NopCloser(strings.NewReader(m.echo("sa-1")))
+	case req.Method == http.MethodGet && p == prefix:
+		resp.Body = io.NopCloser(strings.NewReader(m.accounts))
+	case req.Method == http.MethodGet && strings.HasPrefix(p, prefix) && strings.HasSuffix(p, "/tokens/"):
+		id := strings.TrimSuffix(strings.TrimPrefix(p, prefix), "/tokens/")
+		tokens, ok := m.tokens[id]
+		if !ok {
+			tokens = "[]"
+		}
+		resp.Body = io.NopCloser(strings.NewReader(tokens))
+	default:
+		resp.StatusCode = http.StatusNotFound
+		resp.Body = io.NopCloser(strings.NewReader(`{"error": "Not found"}`))
+	}
+	return resp, nil
+}
+
+// echo returns the service account described by the last request body.
+func (m *MockServiceAccountRoundTripper) echo(id string) string {
+	sa := map[string]interface{}{
+		"id":         id,
+		"name":       "ci",
+		"scopes":     []string{"read_workspaces"},
+		"is_active":  true,
+		"created_at": "2025-01-01T10:00:00Z",
+	}
+	if orgs, ok := m.lastBody["organization_names"]; ok {
+		sa["organization_names"] = orgs
+	}
+	out, _ := json.Marshal(sa)
+	return string(out)
+}
+
+func setupTestServiceAccountResource(t *testing.T) (*ServiceAccountResource, *MockServiceAccountRoundTripper, schema.Schema) {
+	t.Helper()
+	mock := &MockServiceAccountRoundTripper{}
+	r := &ServiceAccountResource{provider: &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return r, mock, schemaResp.Schema
+}
+
+func newTestServiceAccountModel(orgs types.Set) ServiceAccountResourceModel {
+	return ServiceAccountResourceModel{
+		ID:                types.StringUnknown(),
+		Name:              types.StringValue("ci"),
+		Description:       types.StringUnknown(),
+		Scopes:            types.ListValueMust(types.StringType, nil),
+		IsActive:          types.BoolValue(true),
+		CreatedAt:         types.StringUnknown(),
+		OrganizationNames: orgs,
+	}
+}
+
+func TestServiceAccountResource_ScopesValidation(t *testing.T) {
+	_, _, s := setupTestServiceAccountResource(t)
+	ctx := context.Background()
+	scopesAttr := s.Attributes["scopes"].(schema.ListAttribute)
+
+	tests := []struct {
+		name    string
+		scopes  []string
+		wantErr bool
+	}{
+		{name: "known", scopes: []string{"read_workspaces", "write_teams"}},
+		{name: "unknown", scopes: []string{"read_workspaces", "admin"}, wantErr: true},
+		{name: "duplicate", scopes: []string{"read_workspaces", "read_workspaces"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, diags := types.ListValueFrom(ctx, types.StringType, tt.scopes)
+			require.False(t, diags.HasError())
+
+			resp := &validator.ListResponse{}
+			for _, v := range scopesAttr.Validators {
+				v.ValidateList(ctx, validator.ListRequest{Path: path.Root("scopes"), ConfigValue: value}, resp)
+			}
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestServiceAccountResource_OrganizationNames(t *testing.T) {
+	r, mock, s := setupTestServiceAccountResource(t)
+	ctx := context.Background()
+
+	plan := newTestServiceAccountModel(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test-org")}))
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	require.Empty(t, createReq.Plan.Set(ctx, &plan))
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: s}}
+	r.Create(ctx, createReq, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics.Errors())
+	assert.Equal(t, []interface{}{"test-org"}, mock.lastBody["organization_names"])
+
+	var state ServiceAccountResourceModel
+	require.Empty(t, createResp.State.Get(ctx, &state))
+	var orgs []string
+	require.Empty(t, state.OrganizationNames.ElementsAs(ctx, &orgs, false))
+	assert.Equal(t, []string{"test-org"}, orgs)
+
+	// Removing the restriction sends an empty list and stores null.
+	updated := newTestServiceAccountModel(types.SetNull(types.StringType))
+	updated.ID = state.ID
+	updateReq := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s},
+		State: createResp.State,
+	}
+	require.Empty(t, updateReq.Plan.Set(ctx, &updated))
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: s}}
+	r.Update(ctx, updateReq, updateResp)
+	require.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics.Errors())
+	assert.Equal(t, []interface{}{}, mock.lastBody["organization_names"])
+
+	require.Empty(t, updateResp.State.Get(ctx, &state))
+	assert.True(t, state.OrganizationNames.IsNull())
+}
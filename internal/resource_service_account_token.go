@@ -380,3 +380,48 @@ func (r *ServiceAccountTokenResource) ImportState(ctx context.Context, req resou
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// listServiceAccountTokens returns every token of a service account,
+// including expired ones.
+func listServiceAccountTokens(p *InfradotsProvider, serviceAccountID string) ([]ServiceAccountTokenObject, error) {
+	url := fmt.Sprintf("https://%s/api/admin/service-accounts/%s/tokens/", p.host, serviceAccountID)
+	httpReq, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var tokens []ServiceAccountTokenObject
+	if err := json.Unmarshal(respBody, &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// tokenExpired reports whether tok has expired at now. Tokens without an
+// expiration never expire.
+func tokenExpired(tok ServiceAccountTokenObject, now time.Time) bool {
+	return tok.Expiration != nil && !tok.Expiration.After(now)
+}
+
+// formatOptionalTime formats t as RFC3339, or returns "" for a nil time.
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
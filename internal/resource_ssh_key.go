@@ -0,0 +1,460 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &SSHKeyResource{}
+	_ resource.ResourceWithImportState = &SSHKeyResource{}
+)
+
+// sshKeyTypes are the key types the platform can generate.
+var sshKeyTypes = []string{"ed25519", "rsa"}
+
+func NewSSHKeyResource() resource.Resource {
+	return &SSHKeyResource{}
+}
+
+type SSHKeyResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	OrganizationName  types.String `tfsdk:"organization_name"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	PrivateKey        types.String `tfsdk:"private_key"`
+	PrivateKeyVersion types.Int64  `tfsdk:"private_key_version"`
+	KeyType           types.String `tfsdk:"key_type"`
+	PublicKey         types.String `tfsdk:"public_key"`
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+}
+
+type SSHKeyAPIResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	KeyType     string    `json:"key_type"`
+	PublicKey   string    `json:"public_key"`
+	Fingerprint string    `json:"fingerprint"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// SSHKeyCreateRequest uploads PrivateKey, or asks the platform to generate
+// a key of KeyType when it is empty.
+type SSHKeyCreateRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	PrivateKey  string `json:"private_key,omitempty"`
+	KeyType     string `json:"key_type,omitempty"`
+}
+
+type SSHKeyUpdateRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// SSHKeyResource manages an SSH key pair of an organization. The private key
+// never leaves the platform once stored; workspaces and VCS connections refer
+// to the key by ID.
+type SSHKeyResource struct {
+	provider *InfradotsProvider
+}
+
+func (r *SSHKeyResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "infradots_ssh_key"
+}
+
+func (r *SSHKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An SSH key pair stored in an organization, used by workspaces to clone repositories and private modules. Upload an existing private key or let the platform generate one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The SSH key unique ID (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization the key belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the SSH key.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the SSH key.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"private_key": schema.StringAttribute{
+				Description: "The PEM-encoded private key to upload. If not set, the platform generates a key pair. Write-only: it is sent to the API but never stored in the plan or state, so a new key is only uploaded when private_key_version changes.",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					privateKeyValidator{},
+				},
+			},
+			"private_key_version": schema.Int64Attribute{
+				Description: "Any value that changes when private_key is rotated, e.g. 1, 2, 3. Changing it replaces the key with one uploaded from the current private_key.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"key_type": schema.StringAttribute{
+				Description: "The type of key to generate: ed25519 or rsa. Defaults to ed25519. Cannot be set with private_key; the type of an uploaded key is reported by the platform.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(sshKeyTypes...),
+					stringvalidator.ConflictsWith(path.MatchRoot("private_key")),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The public key in OpenSSH authorized_keys format, to register as a deploy key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Description: "The SHA256 fingerprint of the key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the key was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SSHKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		if provider, ok := req.ProviderData.(*InfradotsProvider); ok {
+			r.provider = provider
+		}
+	}
+}
+
+// fetchSSHKey returns an SSH key of the organization along with the response
+// status code, so callers can tell a deleted key (404) from other failures.
+func fetchSSHKey(p *InfradotsProvider, organizationName, keyID string) (*SSHKeyAPIResponse, int, error) {
+	url := fmt.Sprintf("https://%s/api/organizations/%s/ssh-keys/%s/", p.host, organizationName, keyID)
+
+	reqHttp, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(reqHttp)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, httpResp.StatusCode, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, httpResp.StatusCode, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var key SSHKeyAPIResponse
+	if err := json.Unmarshal(respBody, &key); err != nil {
+		return nil, httpResp.StatusCode, err
+	}
+	return &key, httpResp.StatusCode, nil
+}
+
+// listSSHKeys returns every SSH key of the organization.
+func listSSHKeys(p *InfradotsProvider, organizationName string) ([]SSHKeyAPIResponse, error) {
+	url := fmt.Sprintf("https://%s/api/organizations/%s/ssh-keys/", p.host, organizationName)
+
+	reqHttp, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(reqHttp)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var keys []SSHKeyAPIResponse
+	if err := json.Unmarshal(respBody, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// mapSSHKeyResponseToModel stores the API response in data. private_key is
+// write-only and never read back.
+func mapSSHKeyResponseToModel(data *SSHKeyResourceModel, key SSHKeyAPIResponse) {
+	data.ID = types.StringValue(key.ID)
+	data.Name = types.StringValue(key.Name)
+	data.Description = types.StringValue(key.Description)
+	data.KeyType = types.StringValue(key.KeyType)
+	data.PublicKey = types.StringValue(key.PublicKey)
+	data.Fingerprint = types.StringValue(key.Fingerprint)
+	data.CreatedAt = types.StringValue(key.CreatedAt.Format(time.RFC3339))
+}
+
+func (r *SSHKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SSHKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// private_key is write-only and only present in the configuration.
+	var privateKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key"), &privateKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := SSHKeyCreateRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	}
+	if !privateKey.IsNull() {
+		createReq.PrivateKey = privateKey.ValueString()
+	} else if !data.KeyType.IsNull() && !data.KeyType.IsUnknown() {
+		createReq.KeyType = data.KeyType.ValueString()
+	} else {
+		createReq.KeyType = sshKeyTypes[0]
+	}
+
+	reqBody, err := json.Marshal(createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error marshaling request", err.Error())
+		return
+	}
+
+	url := fmt.Sprintf("https://%s/api/organizations/%s/ssh-keys/", r.provider.host, data.OrganizationName.ValueString())
+	reqHttp, err := http.NewRequest(http.MethodPost, url, strings.NewReader(string(reqBody)))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
+	reqHttp.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := r.provider.client.Do(reqHttp)
+	if err != nil {
+		resp.Diagnostics.AddError("HTTP request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading response body", err.Error())
+		return
+	}
+	if httpResp.StatusCode != 201 {
+		resp.Diagnostics.AddError(
+			"Failed to create SSH key",
+			fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)),
+		)
+		return
+	}
+
+	var key SSHKeyAPIResponse
+	if err := json.Unmarshal(respBody, &key); err != nil {
+		resp.Diagnostics.AddError("Error parsing response", err.Error())
+		return
+	}
+	mapSSHKeyResponseToModel(&data, key)
+
+	tflog.Info(ctx, "SSH Key Resource Created", map[string]any{"fingerprint": key.Fingerprint})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SSHKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, statusCode, err := fetchSSHKey(r.provider, data.OrganizationName.ValueString(), data.ID.ValueString())
+	if statusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Read failed", err.Error())
+		return
+	}
+
+	mapSSHKeyResponseToModel(&data, *key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update renames the key or changes its description. Every other attribute
+// forces replacement.
+func (r *SSHKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SSHKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody, err := json.Marshal(SSHKeyUpdateRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error marshaling request", err.Error())
+		return
+	}
+
+	url := fmt.Sprintf("https://%s/api/organizations/%s/ssh-keys/%s/",
+		r.provider.host, data.OrganizationName.ValueString(), data.ID.ValueString())
+	reqHttp, err := http.NewRequest(http.MethodPatch, url, strings.NewReader(string(reqBody)))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
+	reqHttp.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := r.provider.client.Do(reqHttp)
+	if err != nil {
+		resp.Diagnostics.AddError("HTTP request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading response body", err.Error())
+		return
+	}
+	if httpResp.StatusCode != 200 {
+		resp.Diagnostics.AddError(
+			"Update failed",
+			fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)),
+		)
+		return
+	}
+
+	var key SSHKeyAPIResponse
+	if err := json.Unmarshal(respBody, &key); err != nil {
+		resp.Diagnostics.AddError("Error parsing response", err.Error())
+		return
+	}
+	mapSSHKeyResponseToModel(&data, key)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SSHKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := fmt.Sprintf("https://%s/api/organizations/%s/ssh-keys/%s/",
+		r.provider.host, data.OrganizationName.ValueString(), data.ID.ValueString())
+	reqHttp, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
+
+	httpResp, err := r.provider.client.Do(reqHttp)
+	if err != nil {
+		resp.Diagnostics.AddError("HTTP request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// A key still referenced by a workspace cannot be deleted; the API
+	// explains which one in the body.
+	if httpResp.StatusCode != 204 && httpResp.StatusCode != 200 && httpResp.StatusCode != 404 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		resp.Diagnostics.AddError("Delete failed", fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)))
+	}
+}
+
+func (r *SSHKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Format: "organization_name:ssh_key_id"
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Import ID must be in the format 'organization_name:ssh_key_id'",
+		)
+		return
+	}
+
+	key, _, err := fetchSSHKey(r.provider, parts[0], parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing SSH key", err.Error())
+		return
+	}
+
+	data := SSHKeyResourceModel{
+		OrganizationName: types.StringValue(parts[0]),
+		// The private key cannot be recovered; imported keys are managed
+		// as if they had been generated.
+		PrivateKey: types.StringNull(),
+	}
+	mapSSHKeyResponseToModel(&data, *key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,478 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &SSOConfigurationResource{}
+	_ resource.ResourceWithImportState    = &SSOConfigurationResource{}
+	_ resource.ResourceWithValidateConfig = &SSOConfigurationResource{}
+)
+
+const (
+	ssoProtocolSAML = "saml"
+	ssoProtocolOIDC = "oidc"
+)
+
+// ssoProtocolAttributes lists the attributes that belong to each protocol.
+// Attributes of the other protocol must not be set.
+var ssoProtocolAttributes = map[string][]string{
+	ssoProtocolSAML: {"metadata_url", "entity_id"},
+	ssoProtocolOIDC: {"issuer_url", "client_id", "client_secret"},
+}
+
+// ssoAttributeMappingKeys are the user attributes the platform reads from
+// the identity provider's assertion or ID token.
+var ssoAttributeMappingKeys = []string{"email", "first_name", "last_name", "groups"}
+
+func NewSSOConfigurationResource() resource.Resource {
+	return &SSOConfigurationResource{}
+}
+
+type SSOConfigurationResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	OrganizationName  types.String `tfsdk:"organization_name"`
+	Protocol          types.String `tfsdk:"protocol"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	MetadataURL       types.String `tfsdk:"metadata_url"`
+	EntityID          types.String `tfsdk:"entity_id"`
+	IssuerURL         types.String `tfsdk:"issuer_url"`
+	ClientID          types.String `tfsdk:"client_id"`
+	ClientSecret      types.String `tfsdk:"client_secret"`
+	AttributeMappings types.Map    `tfsdk:"attribute_mappings"`
+	SPEntityID        types.String `tfsdk:"sp_entity_id"`
+	CallbackURL       types.String `tfsdk:"callback_url"`
+}
+
+type SSOConfigurationAPIResponse struct {
+	ID                string            `json:"id"`
+	Protocol          string            `json:"protocol"`
+	Enabled           bool              `json:"enabled"`
+	MetadataURL       string            `json:"metadata_url"`
+	EntityID          string            `json:"entity_id"`
+	IssuerURL         string            `json:"issuer_url"`
+	ClientID          string            `json:"client_id"`
+	AttributeMappings map[string]string `json:"attribute_mappings"`
+	SPEntityID        string            `json:"sp_entity_id"`
+	CallbackURL       string            `json:"callback_url"`
+}
+
+// SSOConfigurationRequest is sent with PUT for both create and update: an
+// organization has at most one SSO configuration. client_secret is omitted
+// when unchanged so the stored secret is kept.
+type SSOConfigurationRequest struct {
+	Protocol          string            `json:"protocol"`
+	Enabled           bool              `json:"enabled"`
+	MetadataURL       string            `json:"metadata_url,omitempty"`
+	EntityID          string            `json:"entity_id,omitempty"`
+	IssuerURL         string            `json:"issuer_url,omitempty"`
+	ClientID          string            `json:"client_id,omitempty"`
+	ClientSecret      string            `json:"client_secret,omitempty"`
+	AttributeMappings map[string]string `json:"attribute_mappings"`
+}
+
+type SSOConfigurationResource struct {
+	provider *InfradotsProvider
+}
+
+func (r *SSOConfigurationResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "infradots_sso_configuration"
+}
+
+func (r *SSOConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "SAML or OIDC single sign-on settings of an organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The SSO configuration unique ID (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization this configuration belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Description: "The single sign-on protocol. One of: saml, oidc.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ssoProtocolSAML, ssoProtocolOIDC),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether users can sign in through the identity provider. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"metadata_url": schema.StringAttribute{
+				Description: "URL of the identity provider's SAML metadata. Required for saml.",
+				Optional:    true,
+			},
+			"entity_id": schema.StringAttribute{
+				Description: "The identity provider's SAML entity ID. Required for saml.",
+				Optional:    true,
+			},
+			"issuer_url": schema.StringAttribute{
+				Description: "The OIDC issuer URL used for discovery. Required for oidc.",
+				Optional:    true,
+			},
+			"client_id": schema.StringAttribute{
+				Description: "The OIDC client ID. Required for oidc.",
+				Optional:    true,
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "The OIDC client secret. Required for oidc. Write-only: the API never returns it.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"attribute_mappings": schema.MapAttribute{
+				Description: "Maps platform user attributes (email, first_name, last_name, groups) to the claim or SAML attribute names sent by the identity provider.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(ssoAttributeMappingKeys...)),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"sp_entity_id": schema.StringAttribute{
+				Description: "The service provider entity ID (audience) to configure in the identity provider.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"callback_url": schema.StringAttribute{
+				Description: "The assertion consumer service URL (saml) or redirect URI (oidc) to configure in the identity provider.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *SSOConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		if provider, ok := req.ProviderData.(*InfradotsProvider); ok {
+			r.provider = provider
+		}
+	}
+}
+
+// ValidateConfig requires the attributes of the selected protocol and rejects
+// those of the other one.
+func (r *SSOConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SSOConfigurationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Protocol.IsNull() || data.Protocol.IsUnknown() {
+		return
+	}
+
+	values := map[string]types.String{
+		"metadata_url":  data.MetadataURL,
+		"entity_id":     data.EntityID,
+		"issuer_url":    data.IssuerURL,
+		"client_id":     data.ClientID,
+		"client_secret": data.ClientSecret,
+	}
+
+	protocol := data.Protocol.ValueString()
+	for p, attrs := range ssoProtocolAttributes {
+		for _, name := range attrs {
+			v := values[name]
+			if p == protocol && v.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Missing required attribute",
+					fmt.Sprintf("%s is required when protocol is %q.", name, protocol),
+				)
+			}
+			if p != protocol && !v.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid attribute for protocol",
+					fmt.Sprintf("%s only applies when protocol is %q.", name, p),
+				)
+			}
+		}
+	}
+}
+
+func (r *SSOConfigurationResource) configurationURL(orgName string) string {
+	return fmt.Sprintf("https://%s/api/organizations/%s/sso/", r.provider.host, orgName)
+}
+
+func ssoConfigurationAPIToModel(ctx context.Context, data *SSOConfigurationResourceModel, config SSOConfigurationAPIResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(config.ID)
+	data.Protocol = types.StringValue(config.Protocol)
+	data.Enabled = types.BoolValue(config.Enabled)
+	data.MetadataURL = optionalString(data.MetadataURL, config.MetadataURL)
+	data.EntityID = optionalString(data.EntityID, config.EntityID)
+	data.IssuerURL = optionalString(data.IssuerURL, config.IssuerURL)
+	data.ClientID = optionalString(data.ClientID, config.ClientID)
+	// client_secret is write-only; keep the configured value.
+	data.SPEntityID = types.StringValue(config.SPEntityID)
+	data.CallbackURL = types.StringValue(config.CallbackURL)
+
+	if len(config.AttributeMappings) == 0 && data.AttributeMappings.IsNull() {
+		data.AttributeMappings = types.MapNull(types.StringType)
+	} else {
+		mappings := config.AttributeMappings
+		if mappings == nil {
+			mappings = map[string]string{}
+		}
+		var d diag.Diagnostics
+		data.AttributeMappings, d = types.MapValueFrom(ctx, types.StringType, mappings)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// put submits the desired configuration and returns the stored one. The
+// client secret is only sent when it differs from priorSecret.
+func (r *SSOConfigurationResource) put(ctx context.Context, data *SSOConfigurationResourceModel, priorSecret types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	body := SSOConfigurationRequest{
+		Protocol:          data.Protocol.ValueString(),
+		Enabled:           data.Enabled.ValueBool(),
+		MetadataURL:       data.MetadataURL.ValueString(),
+		EntityID:          data.EntityID.ValueString(),
+		IssuerURL:         data.IssuerURL.ValueString(),
+		ClientID:          data.ClientID.ValueString(),
+		AttributeMappings: map[string]string{},
+	}
+	if !data.ClientSecret.Equal(priorSecret) {
+		body.ClientSecret = data.ClientSecret.ValueString()
+	}
+	if !data.AttributeMappings.IsNull() {
+		diags.Append(data.AttributeMappings.ElementsAs(ctx, &body.AttributeMappings, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		diags.AddError("Error marshaling request", err.Error())
+		return diags
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPut, r.configurationURL(data.OrganizationName.ValueString()), strings.NewReader(string(reqBody)))
+	if err != nil {
+		diags.AddError("Error creating request", err.Error())
+		return diags
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+r.provider.token)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := r.provider.client.Do(httpReq)
+	if err != nil {
+		diags.AddError("HTTP request failed", err.Error())
+		return diags
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Error reading response body", err.Error())
+		return diags
+	}
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
+		diags.AddError(
+			"Failed to save SSO configuration",
+			fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)),
+		)
+		return diags
+	}
+
+	var config SSOConfigurationAPIResponse
+	if err := json.Unmarshal(respBody, &config); err != nil {
+		diags.AddError("Error parsing response", err.Error())
+		return diags
+	}
+
+	diags.Append(ssoConfigurationAPIToModel(ctx, data, config)...)
+	return diags
+}
+
+// get fetches the organization's configuration. found is false on 404.
+func (r *SSOConfigurationResource) get(ctx context.Context, data *SSOConfigurationResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	httpReq, err := http.NewRequest(http.MethodGet, r.configurationURL(data.OrganizationName.ValueString()), nil)
+	if err != nil {
+		diags.AddError("Error creating request", err.Error())
+		return false, diags
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+r.provider.token)
+
+	httpResp, err := r.provider.client.Do(httpReq)
+	if err != nil {
+		diags.AddError("HTTP request failed", err.Error())
+		return false, diags
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == 404 {
+		return false, diags
+	}
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Error reading response body", err.Error())
+		return false, diags
+	}
+	if httpResp.StatusCode != 200 {
+		diags.AddError("Read failed", fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)))
+		return false, diags
+	}
+
+	var config SSOConfigurationAPIResponse
+	if err := json.Unmarshal(respBody, &config); err != nil {
+		diags.AddError("Error parsing response", err.Error())
+		return false, diags
+	}
+
+	diags.Append(ssoConfigurationAPIToModel(ctx, data, config)...)
+	return true, diags
+}
+
+func (r *SSOConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SSOConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.put(ctx, &data, types.StringNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSOConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SSOConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSOConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SSOConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.put(ctx, &data, state.ClientSecret)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSOConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SSOConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpReq, err := http.NewRequest(http.MethodDelete, r.configurationURL(data.OrganizationName.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+r.provider.token)
+
+	httpResp, err := r.provider.client.Do(httpReq)
+	if err != nil {
+		resp.Diagnostics.AddError("HTTP request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 204 && httpResp.StatusCode != 200 && httpResp.StatusCode != 404 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		resp.Diagnostics.AddError("Delete failed", fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)))
+	}
+}
+
+func (r *SSOConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is the organization name.
+	if req.ID == "" || strings.Contains(req.ID, ":") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Import ID must be the organization name",
+		)
+		return
+	}
+
+	var data SSOConfigurationResourceModel
+	data.OrganizationName = types.StringValue(req.ID)
+	data.MetadataURL = types.StringNull()
+	data.EntityID = types.StringNull()
+	data.IssuerURL = types.StringNull()
+	data.ClientID = types.StringNull()
+	// client_secret cannot be imported (write-only).
+	data.ClientSecret = types.StringNull()
+	data.AttributeMappings = types.MapNull(types.StringType)
+
+	found, diags := r.get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"SSO configuration not found",
+			fmt.Sprintf("Organization '%s' has no SSO configuration", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,169 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSSOConfigurationRoundTripper records the last PUT body and echoes it
+// back with the computed service provider values.
+type MockSSOConfigurationRoundTripper struct {
+	lastBody map[string]any
+}
+
+func (m *MockSSOConfigurationRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusOK,
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	if req.URL.Path != "/api/organizations/test-org/sso/" || req.Method != http.MethodPut {
+		resp.StatusCode = http.StatusNotFound
+		resp.Body = io.NopCloser(strings.NewReader(`{"error": "Not found"}`))
+		return resp, nil
+	}
+
+	m.lastBody = map[string]any{}
+	_ = json.NewDecoder(req.Body).Decode(&m.lastBody)
+	stored := map[string]any{}
+	for k, v := range m.lastBody {
+		if k != "client_secret" {
+			stored[k] = v
+		}
+	}
+	stored["id"] = "sso-1"
+	stored["sp_entity_id"] = "https://app.infradots.com/sso/test-org"
+	stored["callback_url"] = "https://app.infradots.com/sso/test-org/callback"
+	body, _ := json.Marshal(stored)
+	resp.Body = io.NopCloser(strings.NewReader(string(body)))
+	return resp, nil
+}
+
+func setupTestSSOConfigurationResource(t *testing.T) (*SSOConfigurationResource, *MockSSOConfigurationRoundTripper) {
+	t.Helper()
+	mock := &MockSSOConfigurationRoundTripper{}
+	provider := &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}
+	return &SSOConfigurationResource{provider: provider}, mock
+}
+
+func newTestOIDCConfiguration(secret string) SSOConfigurationResourceModel {
+	return SSOConfigurationResourceModel{
+		ID:                types.StringUnknown(),
+		OrganizationName:  types.StringValue("test-org"),
+		Protocol:          types.StringValue("oidc"),
+		Enabled:           types.BoolValue(true),
+		MetadataURL:       types.StringNull(),
+		EntityID:          types.StringNull(),
+		IssuerURL:         types.StringValue("https://example.okta.com"),
+		ClientID:          types.StringValue("client"),
+		ClientSecret:      types.StringValue(secret),
+		AttributeMappings: types.MapValueMust(types.StringType, map[string]attr.Value{"groups": types.StringValue("groups")}),
+		SPEntityID:        types.StringUnknown(),
+		CallbackURL:       types.StringUnknown(),
+	}
+}
+
+func TestSSOConfigurationResource_Metadata(t *testing.T) {
+	r := NewSSOConfigurationResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{}, resp)
+	assert.Equal(t, "infradots_sso_configuration", resp.TypeName)
+}
+
+func TestSSOConfigurationResource_ValidateConfig(t *testing.T) {
+	r, _ := setupTestSSOConfigurationResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	validate := func(data SSOConfigurationResourceModel) *resource.ValidateConfigResponse {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema}
+		require.Empty(t, plan.Set(ctx, &data))
+		resp := &resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw}}, resp)
+		return resp
+	}
+
+	// Valid OIDC configuration
+	resp := validate(newTestOIDCConfiguration("secret"))
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	// SAML without metadata and with OIDC settings left over
+	data := newTestOIDCConfiguration("secret")
+	data.Protocol = types.StringValue("saml")
+	data.EntityID = types.StringValue("http://www.okta.com/exk1")
+	resp = validate(data)
+	summaries := []string{}
+	for _, d := range resp.Diagnostics.Errors() {
+		summaries = append(summaries, d.Summary())
+	}
+	assert.Len(t, summaries, 4)
+	assert.Contains(t, summaries, "Missing required attribute")
+	assert.Contains(t, summaries, "Invalid attribute for protocol")
+}
+
+func TestSSOConfigurationResource_ClientSecretOnlySentWhenChanged(t *testing.T) {
+	r, mock := setupTestSSOConfigurationResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := newTestOIDCConfiguration("secret")
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, createReq.Plan.Set(ctx, &plan))
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, createReq, &createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	assert.Equal(t, "secret", mock.lastBody["client_secret"])
+
+	var state SSOConfigurationResourceModel
+	require.Empty(t, createResp.State.Get(ctx, &state))
+	assert.Equal(t, "sso-1", state.ID.ValueString())
+	assert.Equal(t, "secret", state.ClientSecret.ValueString())
+	assert.True(t, state.MetadataURL.IsNull())
+	assert.Equal(t, "https://app.infradots.com/sso/test-org/callback", state.CallbackURL.ValueString())
+
+	// Disabling SSO keeps the stored secret.
+	plan = state
+	plan.Enabled = types.BoolValue(false)
+	updateReq := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+		State: createResp.State,
+	}
+	require.Empty(t, updateReq.Plan.Set(ctx, &plan))
+	updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, updateReq, &updateResp)
+	require.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics)
+	assert.NotContains(t, mock.lastBody, "client_secret")
+	assert.Equal(t, false, mock.lastBody["enabled"])
+}
+
+func TestSSOConfigurationResource_ImportState_InvalidFormat(t *testing.T) {
+	r, _ := setupTestSSOConfigurationResource(t)
+
+	for _, id := range []string{"", "test-org:extra"} {
+		response := resource.ImportStateResponse{}
+		r.ImportState(context.Background(), resource.ImportStateRequest{ID: id}, &response)
+		require.True(t, response.Diagnostics.HasError(), id)
+		assert.Contains(t, response.Diagnostics.Errors()[0].Summary(), "Invalid import ID format")
+	}
+}
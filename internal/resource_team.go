@@ -8,20 +8,39 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource              = &TeamResource{}
-	_ resource.ResourceWithConfigure = &TeamResource{}
+	_ resource.Resource                   = &TeamResource{}
+	_ resource.ResourceWithConfigure      = &TeamResource{}
+	_ resource.ResourceWithValidateConfig = &TeamResource{}
 )
 
+const (
+	teamRoleMaintainer = "maintainer"
+	teamRoleMember     = "member"
+
+	teamVisibilityVisible = "visible"
+	teamVisibilitySecret  = "secret"
+)
+
+// teamPermissionAttrTypes describes an entry of the computed permissions list.
+var teamPermissionAttrTypes = map[string]attr.Type{
+	"permission": types.StringType,
+	"workspace":  types.StringType,
+}
+
 func NewTeamResource() resource.Resource {
 	return &TeamResource{}
 }
@@ -31,22 +50,42 @@ type TeamResourceModel struct {
 	OrganizationName types.String `tfsdk:"organization_name"`
 	Name             types.String `tfsdk:"name"`
 	Members          types.List   `tfsdk:"members"`
+	Maintainers      types.List   `tfsdk:"maintainers"`
+	Description      types.String `tfsdk:"description"`
+	Visibility       types.String `tfsdk:"visibility"`
+	Permissions      types.List   `tfsdk:"permissions"`
 }
 
+// TeamAPIResponse is a team as returned by the API. Each member is an object
+// with an "email" and a "role" (maintainer or member).
 type TeamAPIResponse struct {
 	ID          string              `json:"id"`
 	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Visibility  string              `json:"visibility"`
 	Members     []map[string]string `json:"members"`
 	Permissions []interface{}       `json:"permissions"`
 }
 
 type TeamCreateRequest struct {
-	Name    string   `json:"name"`
-	Members []string `json:"members,omitempty"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Visibility  string   `json:"visibility,omitempty"`
+	Members     []string `json:"members,omitempty"`
+	Maintainers []string `json:"maintainers,omitempty"`
 }
 
 type TeamUpdateRequest struct {
-	Name string `json:"name,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Visibility  string  `json:"visibility,omitempty"`
+}
+
+// TeamMembersRequest replaces the member list of a team. Maintainers must
+// also be listed in Members; everyone else gets the member role.
+type TeamMembersRequest struct {
+	Members     []string `json:"members"`
+	Maintainers []string `json:"maintainers"`
 }
 
 type TeamResource struct {
@@ -82,6 +121,43 @@ func (r *TeamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 				Computed:    true,
 			},
+			"maintainers": schema.ListAttribute{
+				Description: "Email addresses of the members with the maintainer role. Maintainers can manage the team's membership in the Infradots UI. When members is set, every maintainer must also be listed there; otherwise maintainers are added to the team.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the team.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"visibility": schema.StringAttribute{
+				Description: "Who can see the team: visible (every organization member) or secret (only team members and organization owners).",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(teamVisibilityVisible),
+				Validators: []validator.String{
+					stringvalidator.OneOf(teamVisibilityVisible, teamVisibilitySecret),
+				},
+			},
+			"permissions": schema.ListNestedAttribute{
+				Description: "Permissions granted to the team.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"permission": schema.StringAttribute{
+							Description: "The permission codename.",
+							Computed:    true,
+						},
+						"workspace": schema.StringAttribute{
+							Description: "The workspace the permission is scoped to; empty for organization-level permissions.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -107,6 +183,187 @@ func teamMembersToList(members []map[string]string) types.List {
 	return types.ListValueMust(types.StringType, memberAttrs)
 }
 
+// teamMaintainersToList returns the emails of the members with the maintainer
+// role.
+func teamMaintainersToList(members []map[string]string) types.List {
+	maintainers := []attr.Value{}
+	for _, m := range members {
+		if m["role"] == teamRoleMaintainer {
+			maintainers = append(maintainers, types.StringValue(m["email"]))
+		}
+	}
+	return types.ListValueMust(types.StringType, maintainers)
+}
+
+// teamPermissionsToList converts the permissions of a team. The API returns
+// either bare codenames or objects with a permission and optional workspace.
+func teamPermissionsToList(permissions []interface{}) types.List {
+	elemType := types.ObjectType{AttrTypes: teamPermissionAttrTypes}
+	values := make([]attr.Value, 0, len(permissions))
+	for _, p := range permissions {
+		var codename, workspace string
+		switch v := p.(type) {
+		case string:
+			codename = v
+		case map[string]interface{}:
+			codename, _ = v["permission"].(string)
+			workspace, _ = v["workspace"].(string)
+		default:
+			codename = fmt.Sprintf("%v", v)
+		}
+		values = append(values, types.ObjectValueMust(teamPermissionAttrTypes, map[string]attr.Value{
+			"permission": types.StringValue(codename),
+			"workspace":  types.StringValue(workspace),
+		}))
+	}
+	return types.ListValueMust(elemType, values)
+}
+
+func mapTeamResponseToModel(data *TeamResourceModel, team TeamAPIResponse) {
+	data.ID = types.StringValue(team.ID)
+	data.Name = types.StringValue(team.Name)
+	data.Description = types.StringValue(team.Description)
+	if team.Visibility != "" {
+		data.Visibility = types.StringValue(team.Visibility)
+	} else {
+		data.Visibility = types.StringValue(teamVisibilityVisible)
+	}
+	data.Members = teamMembersToList(team.Members)
+	data.Maintainers = teamMaintainersToList(team.Members)
+	data.Permissions = teamPermissionsToList(team.Permissions)
+}
+
+// fetchTeam reads a team. It returns the HTTP status code so callers can tell
+// a deleted team (404) apart from other failures.
+func fetchTeam(p *InfradotsProvider, organizationName, teamID string) (*TeamAPIResponse, int, error) {
+	url := fmt.Sprintf("https://%s/api/organizations/%s/teams/%s/", p.host, organizationName, teamID)
+
+	reqHttp, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(reqHttp)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, httpResp.StatusCode, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, httpResp.StatusCode, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var team TeamAPIResponse
+	if err := json.Unmarshal(respBody, &team); err != nil {
+		return nil, httpResp.StatusCode, err
+	}
+	return &team, httpResp.StatusCode, nil
+}
+
+// listTeams returns every team of the organization with its members.
+func listTeams(p *InfradotsProvider, organizationName string) ([]TeamAPIResponse, error) {
+	url := fmt.Sprintf("https://%s/api/organizations/%s/teams/", p.host, organizationName)
+
+	reqHttp, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(reqHttp)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var teams []TeamAPIResponse
+	if err := json.Unmarshal(respBody, &teams); err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+// setTeamMembers replaces the member list of a team and the maintainer role
+// assignments within it.
+func setTeamMembers(p *InfradotsProvider, organizationName, teamID string, members, maintainers []string) error {
+	if members == nil {
+		members = []string{}
+	}
+	if maintainers == nil {
+		maintainers = []string{}
+	}
+	reqBody, err := json.Marshal(TeamMembersRequest{Members: members, Maintainers: maintainers})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("https://%s/api/organizations/%s/teams/%s/members/", p.host, organizationName, teamID)
+
+	reqHttp, err := http.NewRequest(http.MethodPost, url, strings.NewReader(string(reqBody)))
+	if err != nil {
+		return err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+p.token)
+	reqHttp.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := p.client.Do(reqHttp)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 204 && httpResp.StatusCode != 200 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+	return nil
+}
+
+// ValidateConfig rejects maintainers that are not listed in members, which
+// would otherwise be added and then show up as a perpetual diff.
+func (r *TeamResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TeamResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Members.IsNull() || data.Members.IsUnknown() || data.Maintainers.IsNull() || data.Maintainers.IsUnknown() {
+		return
+	}
+
+	var members, maintainers []string
+	resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &members, true)...)
+	resp.Diagnostics.Append(data.Maintainers.ElementsAs(ctx, &maintainers, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, m := range stringSetDifference(maintainers, members) {
+		if m == "" {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("maintainers"),
+			"Maintainer is not a member",
+			fmt.Sprintf("'%s' is listed in maintainers but not in members. Every maintainer must also be a member of the team.", m),
+		)
+	}
+}
+
 func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data TeamResourceModel
 	diags := req.Plan.Get(ctx, &data)
@@ -116,7 +373,9 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	createReq := TeamCreateRequest{
-		Name: data.Name.ValueString(),
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Visibility:  data.Visibility.ValueString(),
 	}
 
 	if !data.Members.IsNull() && !data.Members.IsUnknown() {
@@ -129,6 +388,19 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		createReq.Members = members
 	}
 
+	if !data.Maintainers.IsNull() && !data.Maintainers.IsUnknown() {
+		var maintainers []string
+		diags = data.Maintainers.ElementsAs(ctx, &maintainers, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		createReq.Maintainers = maintainers
+		for _, m := range stringSetDifference(maintainers, createReq.Members) {
+			createReq.Members = append(createReq.Members, m)
+		}
+	}
+
 	reqBody, err := json.Marshal(createReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error marshaling request", err.Error())
@@ -175,9 +447,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	data.ID = types.StringValue(team.ID)
-	data.Name = types.StringValue(team.Name)
-	data.Members = teamMembersToList(team.Members)
+	mapTeamResponseToModel(&data, team)
 
 	diags = resp.State.Set(ctx, &data)
 	tflog.Info(ctx, "Team Resource Created", map[string]any{"success": true})
@@ -234,9 +504,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	data.ID = types.StringValue(team.ID)
-	data.Name = types.StringValue(team.Name)
-	data.Members = teamMembersToList(team.Members)
+	mapTeamResponseToModel(&data, team)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -257,10 +525,13 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	// Update team name if changed
-	if !plan.Name.Equal(state.Name) {
+	// Update team settings if changed
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) || !plan.Visibility.Equal(state.Visibility) {
+		description := plan.Description.ValueString()
 		updateReq := TeamUpdateRequest{
-			Name: plan.Name.ValueString(),
+			Name:        plan.Name.ValueString(),
+			Description: &description,
+			Visibility:  plan.Visibility.ValueString(),
 		}
 
 		reqBody, err := json.Marshal(updateReq)
@@ -299,48 +570,44 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
-	// Update members if changed
-	if !plan.Members.Equal(state.Members) && !plan.Members.IsNull() && !plan.Members.IsUnknown() {
+	// Update members and maintainers if changed. An attribute that is not
+	// configured keeps its current value.
+	membersChanged := !plan.Members.Equal(state.Members) && !plan.Members.IsNull() && !plan.Members.IsUnknown()
+	maintainersChanged := !plan.Maintainers.Equal(state.Maintainers) && !plan.Maintainers.IsNull() && !plan.Maintainers.IsUnknown()
+	if membersChanged || maintainersChanged {
+		source := state.Members
+		if membersChanged {
+			source = plan.Members
+		}
 		var members []string
-		diags = plan.Members.ElementsAs(ctx, &members, false)
+		diags = source.ElementsAs(ctx, &members, false)
 		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
 
-		membersReq := map[string][]string{"members": members}
-		reqBody, err := json.Marshal(membersReq)
-		if err != nil {
-			resp.Diagnostics.AddError("Error marshaling members request", err.Error())
-			return
+		source = state.Maintainers
+		if maintainersChanged {
+			source = plan.Maintainers
 		}
-
-		url := fmt.Sprintf("https://%s/api/organizations/%s/teams/%s/members/",
-			r.provider.host,
-			plan.OrganizationName.ValueString(),
-			state.ID.ValueString())
-
-		reqHttp, err := http.NewRequest(http.MethodPost, url, strings.NewReader(string(reqBody)))
-		if err != nil {
-			resp.Diagnostics.AddError("Error creating request", err.Error())
+		var maintainers []string
+		if !source.IsNull() && !source.IsUnknown() {
+			diags = source.ElementsAs(ctx, &maintainers, false)
+			resp.Diagnostics.Append(diags...)
+		}
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
-		reqHttp.Header.Set("Content-Type", "application/json")
 
-		httpResp, err := r.provider.client.Do(reqHttp)
-		if err != nil {
-			resp.Diagnostics.AddError("HTTP request failed", err.Error())
-			return
+		if maintainersChanged {
+			members = append(members, stringSetDifference(maintainers, members)...)
+		} else {
+			// Maintainers that were removed from the team lose the role.
+			maintainers = stringSetDifference(maintainers, stringSetDifference(maintainers, members))
 		}
-		defer httpResp.Body.Close()
 
-		if httpResp.StatusCode != 204 && httpResp.StatusCode != 200 {
-			respBody, _ := io.ReadAll(httpResp.Body)
-			resp.Diagnostics.AddError(
-				"Update members failed",
-				fmt.Sprintf("Status code: %d, Body: %s", httpResp.StatusCode, string(respBody)),
-			)
+		unlock := lockTeamMembers(plan.OrganizationName.ValueString(), state.ID.ValueString())
+		err := setTeamMembers(r.provider, plan.OrganizationName.ValueString(), state.ID.ValueString(), members, maintainers)
+		unlock()
+		if err != nil {
+			resp.Diagnostics.AddError("Update members failed", err.Error())
 			return
 		}
 	}
@@ -388,9 +655,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	plan.ID = types.StringValue(team.ID)
-	plan.Name = types.StringValue(team.Name)
-	plan.Members = teamMembersToList(team.Members)
+	mapTeamResponseToModel(&plan, team)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -510,10 +775,8 @@ func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportState
 	}
 
 	var data TeamResourceModel
-	data.ID = types.StringValue(found.ID)
 	data.OrganizationName = types.StringValue(organizationName)
-	data.Name = types.StringValue(found.Name)
-	data.Members = teamMembersToList(found.Members)
+	mapTeamResponseToModel(&data, *found)
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,405 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &TeamMembershipResource{}
+	_ resource.ResourceWithConfigure   = &TeamMembershipResource{}
+	_ resource.ResourceWithImportState = &TeamMembershipResource{}
+)
+
+func NewTeamMembershipResource() resource.Resource {
+	return &TeamMembershipResource{}
+}
+
+type TeamMembershipResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationName types.String `tfsdk:"organization_name"`
+	TeamID           types.String `tfsdk:"team_id"`
+	UserEmail        types.String `tfsdk:"user_email"`
+	UserID           types.String `tfsdk:"user_id"`
+	Role             types.String `tfsdk:"role"`
+}
+
+type TeamMembershipResource struct {
+	provider *InfradotsProvider
+}
+
+// teamMembersLocks serializes read-modify-write cycles on a team's member
+// list. The API only accepts the full list, so two memberships of the same
+// team applied in parallel would otherwise overwrite each other.
+var teamMembersLocks sync.Map
+
+func lockTeamMembers(organizationName, teamID string) func() {
+	v, _ := teamMembersLocks.LoadOrStore(organizationName+"/"+teamID, &sync.Mutex{})
+	mu := v.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+func (r *TeamMembershipResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "infradots_team_membership"
+}
+
+func (r *TeamMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a single user to a team in an InfraDots organization without managing the team's other members.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Composite ID for this membership (organization_name:team_id:user_email).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the team.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_email": schema.StringAttribute{
+				Description: "The email of the user to add. Exactly one of user_email or user_id must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user to add. Exactly one of user_email or user_id must be set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role of the user in the team: member or maintainer.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(teamRoleMember),
+				Validators: []validator.String{
+					stringvalidator.OneOf(teamRoleMember, teamRoleMaintainer),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		if provider, ok := req.ProviderData.(*InfradotsProvider); ok {
+			r.provider = provider
+		}
+	}
+}
+
+// teamMemberEmails returns the emails of the team members and, separately,
+// of those with the maintainer role.
+func teamMemberEmails(team *TeamAPIResponse) (members, maintainers []string) {
+	members = make([]string, 0, len(team.Members))
+	for _, m := range team.Members {
+		if email, ok := m["email"]; ok {
+			members = append(members, email)
+			if m["role"] == teamRoleMaintainer {
+				maintainers = append(maintainers, email)
+			}
+		}
+	}
+	return members, maintainers
+}
+
+// teamMemberRole returns the role of a member and whether the email is a
+// member of the team at all.
+func teamMemberRole(team *TeamAPIResponse, email string) (string, bool) {
+	for _, m := range team.Members {
+		if strings.EqualFold(m["email"], email) {
+			if m["role"] == teamRoleMaintainer {
+				return teamRoleMaintainer, true
+			}
+			return teamRoleMember, true
+		}
+	}
+	return "", false
+}
+
+func removeEmail(emails []string, email string) []string {
+	result := make([]string, 0, len(emails))
+	for _, e := range emails {
+		if !strings.EqualFold(e, email) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// listOrganizationUsers returns the users of an organization.
+func listOrganizationUsers(p *InfradotsProvider, organizationName string) ([]UserAPIResponse, error) {
+	url := fmt.Sprintf("https://%s/api/users/%s/users/", p.host, organizationName)
+
+	reqHttp, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(reqHttp)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var users []UserAPIResponse
+	if err := json.Unmarshal(respBody, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// resolveUser fills in whichever of user_email and user_id was not configured.
+// The user must already belong to the organization. Emails match regardless of
+// case; a configured user_email keeps its casing unless importing is set, in
+// which case the casing stored by the API is used.
+func (r *TeamMembershipResource) resolveUser(data *TeamMembershipResourceModel, importing bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	users, err := listOrganizationUsers(r.provider, data.OrganizationName.ValueString())
+	if err != nil {
+		diags.AddError("Error listing organization users", err.Error())
+		return diags
+	}
+
+	byID := !data.UserID.IsNull() && !data.UserID.IsUnknown() && data.UserID.ValueString() != ""
+	for _, u := range users {
+		if (byID && u.ID == data.UserID.ValueString()) || (!byID && strings.EqualFold(u.Email, data.UserEmail.ValueString())) {
+			data.UserID = types.StringValue(u.ID)
+			if byID || importing {
+				data.UserEmail = types.StringValue(u.Email)
+			}
+			return diags
+		}
+	}
+
+	who := data.UserEmail.ValueString()
+	if byID {
+		who = data.UserID.ValueString()
+	}
+	diags.AddError(
+		"User not found",
+		fmt.Sprintf("No user '%s' found in organization '%s'. Add the user to the organization (e.g. with infradots_user) first.",
+			who, data.OrganizationName.ValueString()),
+	)
+	return diags
+}
+
+func (r *TeamMembershipResource) computeID(data *TeamMembershipResourceModel) string {
+	return strings.Join([]string{
+		data.OrganizationName.ValueString(),
+		data.TeamID.ValueString(),
+		data.UserEmail.ValueString(),
+	}, ":")
+}
+
+// apply adds the user to the team with the configured role, or removes it
+// when remove is set, leaving every other member and role untouched.
+func (r *TeamMembershipResource) apply(data *TeamMembershipResourceModel, remove bool) (int, error) {
+	org, teamID := data.OrganizationName.ValueString(), data.TeamID.ValueString()
+	unlock := lockTeamMembers(org, teamID)
+	defer unlock()
+
+	team, statusCode, err := fetchTeam(r.provider, org, teamID)
+	if err != nil {
+		return statusCode, err
+	}
+
+	email := data.UserEmail.ValueString()
+	role, isMember := teamMemberRole(team, email)
+	if (remove && !isMember) || (!remove && isMember && role == data.Role.ValueString()) {
+		return statusCode, nil
+	}
+
+	members, maintainers := teamMemberEmails(team)
+	members = removeEmail(members, email)
+	maintainers = removeEmail(maintainers, email)
+	if !remove {
+		members = append(members, email)
+		if data.Role.ValueString() == teamRoleMaintainer {
+			maintainers = append(maintainers, email)
+		}
+	}
+
+	return statusCode, setTeamMembers(r.provider, org, teamID, members, maintainers)
+}
+
+func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamMembershipResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.resolveUser(&data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.apply(&data, false); err != nil {
+		resp.Diagnostics.AddError("Add team member failed", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(r.computeID(&data))
+	diags = resp.State.Set(ctx, &data)
+	tflog.Info(ctx, "Team Membership Resource Created", map[string]any{"success": true})
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamMembershipResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, statusCode, err := fetchTeam(r.provider, data.OrganizationName.ValueString(), data.TeamID.ValueString())
+	if statusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Read failed", err.Error())
+		return
+	}
+
+	role, isMember := teamMemberRole(team, data.UserEmail.ValueString())
+	if !isMember {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Role = types.StringValue(role)
+	data.ID = types.StringValue(r.computeID(&data))
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update changes the role of the member; all other fields are ForceNew.
+func (r *TeamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.apply(&plan, false); err != nil {
+		resp.Diagnostics.AddError("Update team member failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(r.computeID(&plan))
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamMembershipResourceModel
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusCode, err := r.apply(&data, true)
+	if err != nil && statusCode != 404 {
+		resp.Diagnostics.AddError("Remove team member failed", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Import ID must be in the format 'organization_name:team_id:user_email'",
+		)
+		return
+	}
+
+	var data TeamMembershipResourceModel
+	data.OrganizationName = types.StringValue(parts[0])
+	data.TeamID = types.StringValue(parts[1])
+	data.UserEmail = types.StringValue(parts[2])
+	data.UserID = types.StringNull()
+
+	resp.Diagnostics.Append(r.resolveUser(&data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, _, err := fetchTeam(r.provider, parts[0], parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading team", err.Error())
+		return
+	}
+	role, isMember := teamMemberRole(team, data.UserEmail.ValueString())
+	if !isMember {
+		resp.Diagnostics.AddError(
+			"Team membership not found",
+			fmt.Sprintf("User '%s' is not a member of team '%s' in organization '%s'", parts[2], parts[1], parts[0]),
+		)
+		return
+	}
+
+	data.Role = types.StringValue(role)
+	data.ID = types.StringValue(r.computeID(&data))
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
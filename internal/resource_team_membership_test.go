@@ -0,0 +1,272 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTeamMembershipRoundTripper keeps a team member list in memory so tests
+// can check that other members are left untouched.
+type MockTeamMembershipRoundTripper struct {
+	members     []string
+	maintainers []string
+}
+
+func (m *MockTeamMembershipRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusOK,
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	switch {
+	case req.Method == http.MethodGet && req.URL.Path == "/api/users/test-org/users/":
+		resp.Body = io.NopCloser(strings.NewReader(`[
+			{"id": "user-1", "email": "existing@example.com"},
+			{"id": "user-2", "email": "new@example.com"}
+		]`))
+	case req.Method == http.MethodGet && req.URL.Path == "/api/organizations/test-org/teams/team-1/":
+		members := make([]map[string]string, 0, len(m.members))
+		for _, e := range m.members {
+			role := teamRoleMember
+			for _, mt := range m.maintainers {
+				if mt == e {
+					role = teamRoleMaintainer
+				}
+			}
+			members = append(members, map[string]string{"email": e, "role": role})
+		}
+		body, _ := json.Marshal(TeamAPIResponse{ID: "team-1", Name: "devops", Members: members})
+		resp.Body = io.NopCloser(strings.NewReader(string(body)))
+	case req.Method == http.MethodPost && req.URL.Path == "/api/organizations/test-org/teams/team-1/members/":
+		var body TeamMembersRequest
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		m.members = body.Members
+		m.maintainers = body.Maintainers
+		resp.StatusCode = http.StatusNoContent
+		resp.Body = io.NopCloser(strings.NewReader(""))
+	default:
+		resp.StatusCode = http.StatusNotFound
+		resp.Body = io.NopCloser(strings.NewReader(`{"error": "Not found"}`))
+	}
+	return resp, nil
+}
+
+func setupTestTeamMembershipResource(t *testing.T, members ...string) (*TeamMembershipResource, *MockTeamMembershipRoundTripper) {
+	t.Helper()
+	mock := &MockTeamMembershipRoundTripper{members: members}
+	provider := &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}
+	return &TeamMembershipResource{provider: provider}, mock
+}
+
+func TestTeamMembershipResource_Metadata(t *testing.T) {
+	r := NewTeamMembershipResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{}, resp)
+	assert.Equal(t, "infradots_team_membership", resp.TypeName)
+}
+
+func TestTeamMembershipResource_Create_ByUserID(t *testing.T) {
+	r, mock := setupTestTeamMembershipResource(t, "existing@example.com")
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := TeamMembershipResourceModel{
+		ID:               types.StringUnknown(),
+		OrganizationName: types.StringValue("test-org"),
+		TeamID:           types.StringValue("team-1"),
+		UserEmail:        types.StringUnknown(),
+		UserID:           types.StringValue("user-2"),
+		Role:             types.StringValue("member"),
+	}
+	request := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, request.Plan.Set(ctx, &plan))
+
+	response := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	assert.Equal(t, []string{"existing@example.com", "new@example.com"}, mock.members)
+
+	var state TeamMembershipResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "test-org:team-1:new@example.com", state.ID.ValueString())
+	assert.Equal(t, "new@example.com", state.UserEmail.ValueString())
+}
+
+func TestTeamMembershipResource_Create_KeepsEmailCasing(t *testing.T) {
+	r, _ := setupTestTeamMembershipResource(t, "existing@example.com")
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := TeamMembershipResourceModel{
+		ID:               types.StringUnknown(),
+		OrganizationName: types.StringValue("test-org"),
+		TeamID:           types.StringValue("team-1"),
+		UserEmail:        types.StringValue("New@Example.com"),
+		UserID:           types.StringUnknown(),
+		Role:             types.StringValue("member"),
+	}
+	request := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, request.Plan.Set(ctx, &plan))
+
+	response := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	var state TeamMembershipResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "New@Example.com", state.UserEmail.ValueString())
+	assert.Equal(t, "user-2", state.UserID.ValueString())
+}
+
+func TestTeamMembershipResource_Create_UnknownUser(t *testing.T) {
+	r, _ := setupTestTeamMembershipResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := TeamMembershipResourceModel{
+		ID:               types.StringUnknown(),
+		OrganizationName: types.StringValue("test-org"),
+		TeamID:           types.StringValue("team-1"),
+		UserEmail:        types.StringValue("stranger@example.com"),
+		UserID:           types.StringUnknown(),
+		Role:             types.StringValue("member"),
+	}
+	request := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, request.Plan.Set(ctx, &plan))
+
+	response := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, request, &response)
+	require.True(t, response.Diagnostics.HasError())
+	assert.Contains(t, response.Diagnostics.Errors()[0].Summary(), "User not found")
+}
+
+func TestTeamMembershipResource_Delete_KeepsOtherMembers(t *testing.T) {
+	r, mock := setupTestTeamMembershipResource(t, "existing@example.com", "new@example.com")
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := TeamMembershipResourceModel{
+		ID:               types.StringValue("test-org:team-1:new@example.com"),
+		OrganizationName: types.StringValue("test-org"),
+		TeamID:           types.StringValue("team-1"),
+		UserEmail:        types.StringValue("new@example.com"),
+		UserID:           types.StringValue("user-2"),
+		Role:             types.StringValue("member"),
+	}
+	request := resource.DeleteRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+	require.Empty(t, request.State.Set(ctx, &state))
+
+	response := resource.DeleteResponse{State: request.State}
+	r.Delete(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	assert.Equal(t, []string{"existing@example.com"}, mock.members)
+}
+
+func TestTeamMembershipResource_Read_Removed(t *testing.T) {
+	r, _ := setupTestTeamMembershipResource(t, "existing@example.com")
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := TeamMembershipResourceModel{
+		ID:               types.StringValue("test-org:team-1:new@example.com"),
+		OrganizationName: types.StringValue("test-org"),
+		TeamID:           types.StringValue("team-1"),
+		UserEmail:        types.StringValue("new@example.com"),
+		UserID:           types.StringValue("user-2"),
+		Role:             types.StringValue("member"),
+	}
+	request := resource.ReadRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+	require.Empty(t, request.State.Set(ctx, &state))
+
+	response := resource.ReadResponse{State: request.State}
+	r.Read(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+	assert.True(t, response.State.Raw.IsNull())
+}
+
+func TestTeamMembershipResource_ImportState(t *testing.T) {
+	r, _ := setupTestTeamMembershipResource(t, "existing@example.com")
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	response := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "test-org:team-1:Existing@Example.com"}, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	var state TeamMembershipResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "user-1", state.UserID.ValueString())
+	assert.Equal(t, "existing@example.com", state.UserEmail.ValueString())
+}
+
+func TestTeamMembershipResource_ImportState_InvalidFormat(t *testing.T) {
+	r, _ := setupTestTeamMembershipResource(t)
+	response := resource.ImportStateResponse{}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "test-org:team-1"}, &response)
+	require.True(t, response.Diagnostics.HasError())
+	assert.Contains(t, response.Diagnostics.Errors()[0].Summary(), "Invalid import ID format")
+}
+
+func TestTeamMembershipResource_Update_Role(t *testing.T) {
+	r, mock := setupTestTeamMembershipResource(t, "existing@example.com", "new@example.com")
+	mock.maintainers = []string{"existing@example.com"}
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := TeamMembershipResourceModel{
+		ID:               types.StringValue("test-org:team-1:new@example.com"),
+		OrganizationName: types.StringValue("test-org"),
+		TeamID:           types.StringValue("team-1"),
+		UserEmail:        types.StringValue("new@example.com"),
+		UserID:           types.StringValue("user-2"),
+		Role:             types.StringValue("member"),
+	}
+	plan := state
+	plan.Role = types.StringValue("maintainer")
+
+	request := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	require.Empty(t, request.Plan.Set(ctx, &plan))
+	require.Empty(t, request.State.Set(ctx, &state))
+
+	response := resource.UpdateResponse{State: request.State}
+	r.Update(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	assert.Equal(t, []string{"existing@example.com", "new@example.com"}, mock.members)
+	assert.Equal(t, []string{"existing@example.com", "new@example.com"}, mock.maintainers)
+}
@@ -0,0 +1,281 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &TeamSSOMappingResource{}
+	_ resource.ResourceWithImportState = &TeamSSOMappingResource{}
+)
+
+func NewTeamSSOMappingResource() resource.Resource {
+	return &TeamSSOMappingResource{}
+}
+
+type TeamSSOMappingResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationName types.String `tfsdk:"organization_name"`
+	TeamID           types.String `tfsdk:"team_id"`
+	GroupName        types.String `tfsdk:"group_name"`
+}
+
+type TeamSSOMappingAPIResponse struct {
+	ID        string `json:"id"`
+	GroupName string `json:"group_name"`
+}
+
+type TeamSSOMappingCreateRequest struct {
+	GroupName string `json:"group_name"`
+}
+
+type TeamSSOMappingResource struct {
+	provider *InfradotsProvider
+}
+
+func (r *TeamSSOMappingResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "infradots_team_sso_mapping"
+}
+
+func (r *TeamSSOMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Binds a team to an identity provider group. Members of the group are added to the team when they sign in through SSO.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The mapping unique ID (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "The name of the organization the team belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the team.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_name": schema.StringAttribute{
+				Description: "The group name as sent by the identity provider in the groups attribute or claim.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamSSOMappingResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData != nil {
+		if provider, ok := req.ProviderData.(*InfradotsProvider); ok {
+			r.provider = provider
+		}
+	}
+}
+
+func (r *TeamSSOMappingResource) mappingsURL(orgName, teamID string) string {
+	return fmt.Sprintf("https://%s/api/organizations/%s/teams/%s/sso-groups/", r.provider.host, orgName, teamID)
+}
+
+// findMapping returns the team's mapping for groupName, or nil if there is
+// none. A missing team is reported as a nil mapping as well.
+func (r *TeamSSOMappingResource) findMapping(orgName, teamID, groupName string) (*TeamSSOMappingAPIResponse, error) {
+	reqHttp, err := http.NewRequest(http.MethodGet, r.mappingsURL(orgName, teamID), nil)
+	if err != nil {
+		return nil, err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
+
+	httpResp, err := r.provider.client.Do(reqHttp)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == 404 {
+		return nil, nil
+	}
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("status: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var mappings []TeamSSOMappingAPIResponse
+	if err := json.Unmarshal(respBody, &mappings); err != nil {
+		return nil, err
+	}
+	for i := range mappings {
+		if mappings[i].GroupName == groupName {
+			return &mappings[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *TeamSSOMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamSSOMappingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody, err := json.Marshal(TeamSSOMappingCreateRequest{GroupName: data.GroupName.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Error marshaling request", err.Error())
+		return
+	}
+
+	url := r.mappingsURL(data.OrganizationName.ValueString(), data.TeamID.ValueString())
+	reqHttp, err := http.NewRequest(http.MethodPost, url, strings.NewReader(string(reqBody)))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
+	reqHttp.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := r.provider.client.Do(reqHttp)
+	if err != nil {
+		resp.Diagnostics.AddError("HTTP request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading response body", err.Error())
+		return
+	}
+	if httpResp.StatusCode != 201 && httpResp.StatusCode != 200 {
+		resp.Diagnostics.AddError(
+			"Failed to create team SSO mapping",
+			fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)),
+		)
+		return
+	}
+
+	var mapping TeamSSOMappingAPIResponse
+	if err := json.Unmarshal(respBody, &mapping); err != nil {
+		resp.Diagnostics.AddError("Error parsing response", err.Error())
+		return
+	}
+	data.ID = types.StringValue(mapping.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamSSOMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamSSOMappingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapping, err := r.findMapping(data.OrganizationName.ValueString(), data.TeamID.ValueString(), data.GroupName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Read failed", err.Error())
+		return
+	}
+	if mapping == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.ID = types.StringValue(mapping.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamSSOMappingResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute forces replacement.
+	resp.Diagnostics.AddError("Update not supported", "Team SSO mappings cannot be updated in place.")
+}
+
+func (r *TeamSSOMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamSSOMappingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := r.mappingsURL(data.OrganizationName.ValueString(), data.TeamID.ValueString()) + data.ID.ValueString() + "/"
+	reqHttp, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating request", err.Error())
+		return
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
+
+	httpResp, err := r.provider.client.Do(reqHttp)
+	if err != nil {
+		resp.Diagnostics.AddError("HTTP request failed", err.Error())
+		return
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != 204 && httpResp.StatusCode != 200 && httpResp.StatusCode != 404 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		resp.Diagnostics.AddError("Delete failed", fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)))
+	}
+}
+
+func (r *TeamSSOMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Format: "organization_name:team_id:group_name". Group names may contain
+	// colons, so everything after the second colon is the group name.
+	parts := strings.SplitN(req.ID, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Import ID must be in the format 'organization_name:team_id:group_name'",
+		)
+		return
+	}
+
+	mapping, err := r.findMapping(parts[0], parts[1], parts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Read failed", err.Error())
+		return
+	}
+	if mapping == nil {
+		resp.Diagnostics.AddError(
+			"Team SSO mapping not found",
+			fmt.Sprintf("Team '%s' in organization '%s' is not mapped to group '%s'", parts[1], parts[0], parts[2]),
+		)
+		return
+	}
+
+	data := TeamSSOMappingResourceModel{
+		ID:               types.StringValue(mapping.ID),
+		OrganizationName: types.StringValue(parts[0]),
+		TeamID:           types.StringValue(parts[1]),
+		GroupName:        types.StringValue(mapping.GroupName),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTeamSSOMappingRoundTripper keeps the group mappings of team-1 in memory.
+type MockTeamSSOMappingRoundTripper struct {
+	mappings []TeamSSOMappingAPIResponse
+}
+
+func (m *MockTeamSSOMappingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusOK,
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	const base = "/api/organizations/test-org/teams/team-1/sso-groups/"
+	switch {
+	case req.Method == http.MethodGet && req.URL.Path == base:
+		body, _ := json.Marshal(m.mappings)
+		resp.Body = io.NopCloser(strings.NewReader(string(body)))
+	case req.Method == http.MethodPost && req.URL.Path == base:
+		var body TeamSSOMappingCreateRequest
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		mapping := TeamSSOMappingAPIResponse{ID: "mapping-1", GroupName: body.GroupName}
+		m.mappings = append(m.mappings, mapping)
+		out, _ := json.Marshal(mapping)
+		resp.StatusCode = http.StatusCreated
+		resp.Body = io.NopCloser(strings.NewReader(string(out)))
+	case req.Method == http.MethodDelete && req.URL.Path == base+"mapping-1/":
+		m.mappings = nil
+		resp.StatusCode = http.StatusNoContent
+		resp.Body = io.NopCloser(strings.NewReader(""))
+	default:
+		resp.StatusCode = http.StatusNotFound
+		resp.Body = io.NopCloser(strings.NewReader(`{"error": "Not found"}`))
+	}
+	return resp, nil
+}
+
+func setupTestTeamSSOMappingResource(t *testing.T, mappings ...TeamSSOMappingAPIResponse) (*TeamSSOMappingResource, *MockTeamSSOMappingRoundTripper) {
+	t.Helper()
+	mock := &MockTeamSSOMappingRoundTripper{mappings: mappings}
+	provider := &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}
+	return &TeamSSOMappingResource{provider: provider}, mock
+}
+
+func TestTeamSSOMappingResource_Metadata(t *testing.T) {
+	r := NewTeamSSOMappingResource()
+	resp := &resource.MetadataResponse{}
+	r.Metadata(context.Background(), resource.MetadataRequest{}, resp)
+	assert.Equal(t, "infradots_team_sso_mapping", resp.TypeName)
+}
+
+func TestTeamSSOMappingResource_CreateReadDelete(t *testing.T) {
+	r, mock := setupTestTeamSSOMappingResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := TeamSSOMappingResourceModel{
+		ID:               types.StringUnknown(),
+		OrganizationName: types.StringValue("test-org"),
+		TeamID:           types.StringValue("team-1"),
+		GroupName:        types.StringValue("platform-engineers"),
+	}
+	createReq := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, createReq.Plan.Set(ctx, &plan))
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, createReq, &createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	var state TeamSSOMappingResourceModel
+	require.Empty(t, createResp.State.Get(ctx, &state))
+	assert.Equal(t, "mapping-1", state.ID.ValueString())
+	require.Len(t, mock.mappings, 1)
+
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.False(t, readResp.State.Raw.IsNull())
+
+	deleteResp := resource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: createResp.State}, &deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+	assert.Empty(t, mock.mappings)
+
+	// The mapping is gone, so Read removes it from state.
+	readResp = resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.IsNull())
+}
+
+func TestTeamSSOMappingResource_ImportState(t *testing.T) {
+	r, _ := setupTestTeamSSOMappingResource(t, TeamSSOMappingAPIResponse{ID: "mapping-1", GroupName: "aad:platform"})
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	response := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "test-org:team-1:aad:platform"}, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+	var state TeamSSOMappingResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "mapping-1", state.ID.ValueString())
+	assert.Equal(t, "aad:platform", state.GroupName.ValueString())
+
+	for _, id := range []string{"test-org:team-1", "test-org::group"} {
+		response := resource.ImportStateResponse{}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, &response)
+		require.True(t, response.Diagnostics.HasError(), id)
+		assert.Contains(t, response.Diagnostics.Errors()[0].Summary(), "Invalid import ID format")
+	}
+
+	response = resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "test-org:team-1:unknown"}, &response)
+	require.True(t, response.Diagnostics.HasError())
+	assert.Contains(t, response.Diagnostics.Errors()[0].Summary(), "not found")
+}
@@ -139,6 +139,10 @@ func TestTeamResource_Create(t *testing.T) {
 	var plan TeamResourceModel
 	plan.OrganizationName = types.StringValue("test-org")
 	plan.Name = types.StringValue("devops-team")
+	plan.Maintainers = types.ListNull(types.StringType)
+	plan.Description = types.StringValue("")
+	plan.Visibility = types.StringValue("visible")
+	plan.Permissions = types.ListNull(types.ObjectType{AttrTypes: teamPermissionAttrTypes})
 	plan.Members = types.ListValueMust(types.StringType, []attr.Value{
 		types.StringValue("user1@example.com"),
 		types.StringValue("user2@example.com"),
@@ -183,6 +187,10 @@ func TestTeamResource_Read(t *testing.T) {
 	state.ID = types.StringValue("a1b2c3d4-e5f6-7890-abcd-ef1234567890")
 	state.OrganizationName = types.StringValue("test-org")
 	state.Name = types.StringValue("devops-team")
+	state.Maintainers = types.ListNull(types.StringType)
+	state.Description = types.StringValue("")
+	state.Visibility = types.StringValue("visible")
+	state.Permissions = types.ListNull(types.ObjectType{AttrTypes: teamPermissionAttrTypes})
 	state.Members = types.ListValueMust(types.StringType, []attr.Value{})
 
 	schemaResp := &resource.SchemaResponse{}
@@ -221,6 +229,10 @@ func TestTeamResource_Delete(t *testing.T) {
 	state.ID = types.StringValue("a1b2c3d4-e5f6-7890-abcd-ef1234567890")
 	state.OrganizationName = types.StringValue("test-org")
 	state.Name = types.StringValue("devops-team")
+	state.Maintainers = types.ListNull(types.StringType)
+	state.Description = types.StringValue("")
+	state.Visibility = types.StringValue("visible")
+	state.Permissions = types.ListNull(types.ObjectType{AttrTypes: teamPermissionAttrTypes})
 	state.Members = types.ListValueMust(types.StringType, []attr.Value{})
 
 	schemaResp := &resource.SchemaResponse{}
@@ -296,3 +308,54 @@ func TestTeamResource_ImportState_NotFound(t *testing.T) {
 	require.True(t, response.Diagnostics.HasError())
 	assert.Contains(t, response.Diagnostics.Errors()[0].Summary(), "Team not found")
 }
+
+func TestTeamMaintainersToList(t *testing.T) {
+	list := teamMaintainersToList([]map[string]string{
+		{"email": "lead@example.com", "role": "maintainer"},
+		{"email": "dev@example.com", "role": "member"},
+		{"email": "legacy@example.com"},
+	})
+	assert.Equal(t, []attr.Value{types.StringValue("lead@example.com")}, list.Elements())
+}
+
+func TestTeamPermissionsToList(t *testing.T) {
+	list := teamPermissionsToList([]interface{}{
+		"read_workspaces",
+		map[string]interface{}{"permission": "write_workspaces", "workspace": "prod"},
+	})
+	require.Len(t, list.Elements(), 2)
+
+	first := list.Elements()[0].(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("read_workspaces"), first["permission"])
+	assert.Equal(t, types.StringValue(""), first["workspace"])
+
+	second := list.Elements()[1].(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("write_workspaces"), second["permission"])
+	assert.Equal(t, types.StringValue("prod"), second["workspace"])
+}
+
+func TestTeamResource_ValidateConfig_MaintainerNotMember(t *testing.T) {
+	r := NewTeamResource().(*TeamResource)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	var config TeamResourceModel
+	config.ID = types.StringNull()
+	config.OrganizationName = types.StringValue("test-org")
+	config.Name = types.StringValue("devops-team")
+	config.Members = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("dev@example.com")})
+	config.Maintainers = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("lead@example.com")})
+	config.Description = types.StringNull()
+	config.Visibility = types.StringNull()
+	config.Permissions = types.ListNull(types.ObjectType{AttrTypes: teamPermissionAttrTypes})
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	require.Empty(t, plan.Set(ctx, &config))
+
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw}}, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Maintainer is not a member")
+}
@@ -6,15 +6,21 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,14 +31,30 @@ func NewUserResource() resource.Resource {
 	return &UserResource{}
 }
 
+// Invitation states reported by the API for organization members.
+const (
+	userInvitationPending  = "pending"
+	userInvitationAccepted = "accepted"
+	userInvitationExpired  = "expired"
+)
+
+// userInvitationPollInterval is how often the invitation status is checked
+// while waiting for acceptance.
+var userInvitationPollInterval = 15 * time.Second
+
 // UserResourceModel maps the user resource schema data.
 type UserResourceModel struct {
-	ID               types.String `tfsdk:"id"`                // User UUID
-	OrganizationName types.String `tfsdk:"organization_name"` // Name of the organization
-	Email            types.String `tfsdk:"email"`             // User email address
-	LastLogin        types.String `tfsdk:"last_login"`        // Last login timestamp
-	Teams            types.List   `tfsdk:"teams"`             // List of teams
-	Permissions      types.List   `tfsdk:"permissions"`       // List of permissions
+	ID                types.String `tfsdk:"id"`                         // User UUID
+	OrganizationName  types.String `tfsdk:"organization_name"`          // Name of the organization
+	Email             types.String `tfsdk:"email"`                      // User email address
+	LastLogin         types.String `tfsdk:"last_login"`                 // Last login timestamp
+	Teams             types.List   `tfsdk:"teams"`                      // List of teams
+	Permissions       types.List   `tfsdk:"permissions"`                // List of permissions
+	InvitationStatus  types.String `tfsdk:"invitation_status"`          // pending, accepted or expired
+	InvitedAt         types.String `tfsdk:"invited_at"`                 // Invitation timestamp
+	WaitForAcceptance types.Bool   `tfsdk:"wait_for_acceptance"`        // Block apply until the invitation is accepted
+	AcceptanceTimeout types.Int64  `tfsdk:"acceptance_timeout_minutes"` // How long to wait for acceptance
+	ResendInvitation  types.String `tfsdk:"resend_invitation"`          // Changing it resends a pending invitation
 }
 
 // UserAPIResponse represents the JSON structure returned by the API
@@ -42,6 +64,10 @@ type UserAPIResponse struct {
 	LastLogin   *time.Time             `json:"last_login"`
 	Teams       []interface{}          `json:"teams"`
 	Permissions []UserPermissionObject `json:"permissions"`
+	// Invitation fields are omitted by API versions without invitation
+	// tracking; see userInvitationStatus.
+	InvitationStatus string     `json:"invitation_status,omitempty"`
+	InvitedAt        *time.Time `json:"invited_at,omitempty"`
 }
 
 // UserPermissionObject represents a permission in the API response
@@ -104,6 +130,36 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 				Computed: true,
 			},
+			"invitation_status": schema.StringAttribute{
+				Description: "The state of the user's invitation: pending, accepted or expired.",
+				Computed:    true,
+			},
+			"invited_at": schema.StringAttribute{
+				Description: "The timestamp when the user was invited.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_acceptance": schema.BoolAttribute{
+				Description: "If true, apply waits until the user has accepted the invitation and fails if it expires or acceptance_timeout_minutes passes first.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"acceptance_timeout_minutes": schema.Int64Attribute{
+				Description: "How long to wait for the invitation to be accepted when wait_for_acceptance is set. Defaults to 60.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(60),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"resend_invitation": schema.StringAttribute{
+				Description: "Arbitrary value; changing it resends the invitation email while the invitation has not been accepted.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -183,6 +239,16 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Save data back into Terraform state
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !data.WaitForAcceptance.ValueBool() {
+		return
+	}
+
+	// The invitation has been sent at this point. If waiting fails the
+	// resource stays in state and is marked tainted, so the next apply
+	// invites the user again.
+	resp.Diagnostics.Append(r.waitForAcceptance(ctx, &data)...)
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -289,6 +355,19 @@ func (r *UserResource) readUser(ctx context.Context, data *UserResourceModel) di
 	// Update the model with the response data
 	data.ID = types.StringValue(foundUser.ID)
 	data.Email = types.StringValue(foundUser.Email)
+	data.InvitationStatus = types.StringValue(userInvitationStatus(*foundUser))
+	if foundUser.InvitedAt != nil {
+		data.InvitedAt = types.StringValue(foundUser.InvitedAt.Format(time.RFC3339))
+	} else {
+		data.InvitedAt = types.StringNull()
+	}
+	// Settings that only exist in configuration are null after import.
+	if data.WaitForAcceptance.IsNull() || data.WaitForAcceptance.IsUnknown() {
+		data.WaitForAcceptance = types.BoolValue(false)
+	}
+	if data.AcceptanceTimeout.IsNull() || data.AcceptanceTimeout.IsUnknown() {
+		data.AcceptanceTimeout = types.Int64Value(60)
+	}
 
 	// Handle last_login (can be null)
 	if foundUser.LastLogin != nil {
@@ -371,27 +450,161 @@ func (r *UserResource) readUser(ctx context.Context, data *UserResourceModel) di
 	return nil
 }
 
+// userInvitationStatus returns the invitation status of a user. API versions
+// without invitation tracking do not report one; there a user who has logged
+// in has accepted and anyone else is still pending.
+func userInvitationStatus(user UserAPIResponse) string {
+	if user.InvitationStatus != "" {
+		return user.InvitationStatus
+	}
+	if user.LastLogin != nil {
+		return userInvitationAccepted
+	}
+	return userInvitationPending
+}
+
+// waitForAcceptance polls the user until the invitation is accepted. It fails
+// when the invitation expires, the user disappears or the timeout passes.
+func (r *UserResource) waitForAcceptance(ctx context.Context, data *UserResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	timeout := time.Duration(data.AcceptanceTimeout.ValueInt64()) * time.Minute
+	deadline := time.Now().Add(timeout)
+
+	for {
+		if data.ID.IsNull() || data.ID.ValueString() == "" {
+			diags.AddError(
+				"User not found",
+				fmt.Sprintf("User '%s' was removed from organization '%s' while waiting for the invitation to be accepted.",
+					data.Email.ValueString(), data.OrganizationName.ValueString()),
+			)
+			return diags
+		}
+
+		switch data.InvitationStatus.ValueString() {
+		case userInvitationAccepted:
+			return diags
+		case userInvitationExpired:
+			diags.AddAttributeError(
+				path.Root("wait_for_acceptance"),
+				"Invitation expired",
+				fmt.Sprintf("The invitation of '%s' expired before it was accepted. Change resend_invitation to send a new one.",
+					data.Email.ValueString()),
+			)
+			return diags
+		}
+
+		if !time.Now().Before(deadline) {
+			diags.AddAttributeError(
+				path.Root("wait_for_acceptance"),
+				"Timed out waiting for invitation acceptance",
+				fmt.Sprintf("'%s' did not accept the invitation within %s (status: %s).",
+					data.Email.ValueString(), timeout, data.InvitationStatus.ValueString()),
+			)
+			return diags
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Waiting for invitation acceptance interrupted", ctx.Err().Error())
+			return diags
+		case <-time.After(userInvitationPollInterval):
+		}
+
+		diags.Append(r.readUser(ctx, data)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+}
+
+// resendInvitation asks the API to send the invitation email again.
+func (r *UserResource) resendInvitation(data *UserResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// POST to /api/users/{organization_name}/users/{member_email}/resend_invitation/
+	rawURL := fmt.Sprintf("https://%s/api/users/%s/users/%s/resend_invitation/",
+		r.provider.host,
+		data.OrganizationName.ValueString(),
+		url.PathEscape(data.Email.ValueString()))
+
+	reqHttp, err := http.NewRequest(http.MethodPost, rawURL, nil)
+	if err != nil {
+		diags.AddError("Error creating request", err.Error())
+		return diags
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
+
+	httpResp, err := r.provider.client.Do(reqHttp)
+	if err != nil {
+		diags.AddError("HTTP request failed", err.Error())
+		return diags
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Error reading response body", err.Error())
+		return diags
+	}
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 {
+		diags.AddError(
+			"Resending invitation failed",
+			fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)),
+		)
+	}
+	return diags
+}
+
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// For users, we don't support updates through this resource
-	// The email and organization_name are the identifying fields
-	// If they change, Terraform will destroy and recreate the resource
-	var plan UserResourceModel
+	// The email and organization_name are the identifying fields and cannot
+	// be changed in place. Updates only act on the invitation settings.
+	var plan, state UserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Just read the current state to ensure it's up to date
+	// Read the current state to ensure it's up to date
 	readDiags := r.readUser(ctx, &plan)
 	resp.Diagnostics.Append(readDiags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if !plan.ResendInvitation.IsNull() && !plan.ResendInvitation.Equal(state.ResendInvitation) {
+		if plan.InvitationStatus.ValueString() == userInvitationAccepted {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("resend_invitation"),
+				"Invitation already accepted",
+				fmt.Sprintf("'%s' has already accepted the invitation; it was not resent.", plan.Email.ValueString()),
+			)
+		} else {
+			resp.Diagnostics.Append(r.resendInvitation(&plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			resp.Diagnostics.Append(r.readUser(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	// Save updated info
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !plan.WaitForAcceptance.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.waitForAcceptance(ctx, &plan)...)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
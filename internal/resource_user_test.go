@@ -0,0 +1,197 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockUserRoundTripper serves the organization user list. statuses is
+// consumed one entry per list request so tests can simulate an invitation
+// changing state while it is polled; the last entry repeats.
+type MockUserRoundTripper struct {
+	statuses []string
+	resent   int
+}
+
+func (m *MockUserRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusOK,
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	switch {
+	case req.Method == http.MethodPost && req.URL.Path == "/api/users/test-org/users/":
+		resp.Body = io.NopCloser(strings.NewReader(`{"message": "Users added to organization successfully"}`))
+	case req.Method == http.MethodGet && req.URL.Path == "/api/users/test-org/users/":
+		status := m.statuses[0]
+		if len(m.statuses) > 1 {
+			m.statuses = m.statuses[1:]
+		}
+		resp.Body = io.NopCloser(strings.NewReader(`[{"id": "user-1", "email": "jane@example.com",
+			"invitation_status": "` + status + `", "invited_at": "2025-01-01T10:00:00Z",
+			"teams": [], "permissions": []}]`))
+	case req.Method == http.MethodPost && req.URL.Path == "/api/users/test-org/users/jane@example.com/resend_invitation/":
+		m.resent++
+		resp.StatusCode = http.StatusNoContent
+		resp.Body = io.NopCloser(strings.NewReader(""))
+	default:
+		resp.StatusCode = http.StatusNotFound
+		resp.Body = io.NopCloser(strings.NewReader(`{"error": "Not found"}`))
+	}
+	return resp, nil
+}
+
+func setupTestUserResource(t *testing.T, statuses ...string) (*UserResource, *MockUserRoundTripper) {
+	t.Helper()
+	interval := userInvitationPollInterval
+	userInvitationPollInterval = time.Millisecond
+	t.Cleanup(func() { userInvitationPollInterval = interval })
+
+	mock := &MockUserRoundTripper{statuses: statuses}
+	provider := &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}
+	return &UserResource{provider: provider}, mock
+}
+
+func newTestUserModel(wait bool, resend types.String) UserResourceModel {
+	return UserResourceModel{
+		ID:                types.StringUnknown(),
+		OrganizationName:  types.StringValue("test-org"),
+		Email:             types.StringValue("jane@example.com"),
+		LastLogin:         types.StringUnknown(),
+		Teams:             types.ListUnknown(types.StringType),
+		Permissions:       types.ListUnknown(types.ObjectType{AttrTypes: map[string]attr.Type{"user": types.StringType, "permission": types.StringType, "organization": types.StringType, "workspace": types.StringType}}),
+		InvitationStatus:  types.StringUnknown(),
+		InvitedAt:         types.StringUnknown(),
+		WaitForAcceptance: types.BoolValue(wait),
+		AcceptanceTimeout: types.Int64Value(1),
+		ResendInvitation:  resend,
+	}
+}
+
+func TestUserInvitationStatus(t *testing.T) {
+	login := time.Now()
+	assert.Equal(t, "expired", userInvitationStatus(UserAPIResponse{InvitationStatus: "expired"}))
+	assert.Equal(t, "accepted", userInvitationStatus(UserAPIResponse{LastLogin: &login}))
+	assert.Equal(t, "pending", userInvitationStatus(UserAPIResponse{}))
+}
+
+func TestUserResource_Create_Pending(t *testing.T) {
+	r, _ := setupTestUserResource(t, "pending")
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := newTestUserModel(false, types.StringNull())
+	request := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, request.Plan.Set(ctx, &plan))
+
+	response := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+
+	var state UserResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "user-1", state.ID.ValueString())
+	assert.Equal(t, "pending", state.InvitationStatus.ValueString())
+	assert.Equal(t, "2025-01-01T10:00:00Z", state.InvitedAt.ValueString())
+}
+
+func TestUserResource_Create_WaitForAcceptance(t *testing.T) {
+	r, _ := setupTestUserResource(t, "pending", "pending", "accepted")
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := newTestUserModel(true, types.StringNull())
+	request := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, request.Plan.Set(ctx, &plan))
+
+	response := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+
+	var state UserResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "accepted", state.InvitationStatus.ValueString())
+}
+
+func TestUserResource_Create_WaitForAcceptance_Expired(t *testing.T) {
+	r, _ := setupTestUserResource(t, "pending", "expired")
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := newTestUserModel(true, types.StringNull())
+	request := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, request.Plan.Set(ctx, &plan))
+
+	response := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, request, &response)
+	require.True(t, response.Diagnostics.HasError())
+	assert.Equal(t, "Invitation expired", response.Diagnostics.Errors()[0].Summary())
+
+	// The invited user is kept in state so Terraform can taint it.
+	var state UserResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "user-1", state.ID.ValueString())
+}
+
+func TestUserResource_Update_ResendInvitation(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		wantResent int
+	}{
+		{name: "pending", status: "pending", wantResent: 1},
+		{name: "expired", status: "expired", wantResent: 1},
+		{name: "accepted", status: "accepted", wantResent: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mock := setupTestUserResource(t, tt.status)
+			ctx := context.Background()
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			prior := newTestUserModel(false, types.StringValue("1"))
+			prior.ID = types.StringValue("user-1")
+			plan := newTestUserModel(false, types.StringValue("2"))
+			plan.ID = types.StringValue("user-1")
+
+			request := resource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+				State: tfsdk.State{Schema: schemaResp.Schema},
+			}
+			require.Empty(t, request.Plan.Set(ctx, &plan))
+			require.Empty(t, request.State.Set(ctx, &prior))
+
+			response := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Update(ctx, request, &response)
+			require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+			assert.Equal(t, tt.wantResent, mock.resent)
+			assert.Equal(t, tt.wantResent == 0, response.Diagnostics.WarningsCount() == 1)
+		})
+	}
+}
@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,7 +24,41 @@ import (
 )
 
 // Ensure we fully satisfy the resource.Resource interface.
-var _ resource.Resource = &VCSResource{}
+var (
+	_ resource.Resource                   = &VCSResource{}
+	_ resource.ResourceWithValidateConfig = &VCSResource{}
+)
+
+const (
+	vcsConnectionOAuth     = "OAUTH"
+	vcsConnectionSSH       = "SSH"
+	vcsConnectionGitHubApp = "GITHUB_APP"
+)
+
+const (
+	vcsTypeGitHub      = "github"
+	vcsTypeGitLab      = "gitlab"
+	vcsTypeBitbucket   = "bitbucket"
+	vcsTypeAzureDevOps = "azure_devops"
+)
+
+// vcsCloudHosts maps each VCS type to the host of its cloud service. A url on
+// any other host is a self-hosted instance (GitHub Enterprise Server, GitLab
+// self-managed, Bitbucket Data Center or Azure DevOps Server).
+var vcsCloudHosts = map[string][]string{
+	vcsTypeGitHub:      {"github.com"},
+	vcsTypeGitLab:      {"gitlab.com"},
+	vcsTypeBitbucket:   {"bitbucket.org"},
+	vcsTypeAzureDevOps: {"dev.azure.com", "visualstudio.com"},
+}
+
+// vcsTypeConnections lists the connection types each VCS type supports.
+var vcsTypeConnections = map[string][]string{
+	vcsTypeGitHub:      {vcsConnectionOAuth, vcsConnectionSSH, vcsConnectionGitHubApp},
+	vcsTypeGitLab:      {vcsConnectionOAuth, vcsConnectionSSH},
+	vcsTypeBitbucket:   {vcsConnectionOAuth, vcsConnectionSSH},
+	vcsTypeAzureDevOps: {vcsConnectionOAuth, vcsConnectionSSH},
+}
 
 func NewVCSResource() resource.Resource {
 	return &VCSResource{}
@@ -29,20 +66,23 @@ func NewVCSResource() resource.Resource {
 
 // VCSResourceModel maps the VCS resource schema data.
 type VCSResourceModel struct {
-	ID               types.String `tfsdk:"id"`                // UUID
-	OrganizationName types.String `tfsdk:"organization_name"` // Name of the organization
-	Name             types.String `tfsdk:"name"`              // VCS name
-	VcsType          types.String `tfsdk:"vcs_type"`          // VCS type (e.g., "github", "gitlab", "bitbucket")
-	URL              types.String `tfsdk:"url"`               // VCS URL
-	ClientId         types.String `tfsdk:"client_id"`         // VCS Client ID
-	ClientSecret     types.String `tfsdk:"client_secret"`     // VCS Client Secret
-	Description      types.String `tfsdk:"description"`       // Optional description
-	CreatedAt        types.String `tfsdk:"created_at"`        // Timestamp
-	UpdatedAt        types.String `tfsdk:"updated_at"`        // Timestamp
-	ConnectionType   types.String `tfsdk:"connection_type"`   // OAUTH or SSH
-	PrivateKey       types.String `tfsdk:"private_key"`       // SSH private key (write-only)
-	Endpoint         types.String `tfsdk:"endpoint"`          // Base URL for self-hosted VCS
-	ApiUrl           types.String `tfsdk:"api_url"`           // API URL for self-hosted VCS
+	ID                types.String `tfsdk:"id"`                  // UUID
+	OrganizationName  types.String `tfsdk:"organization_name"`   // Name of the organization
+	Name              types.String `tfsdk:"name"`                // VCS name
+	VcsType           types.String `tfsdk:"vcs_type"`            // VCS type (e.g., "github", "gitlab", "bitbucket")
+	URL               types.String `tfsdk:"url"`                 // VCS URL
+	ClientId          types.String `tfsdk:"client_id"`           // VCS Client ID
+	ClientSecret      types.String `tfsdk:"client_secret"`       // VCS Client Secret
+	Description       types.String `tfsdk:"description"`         // Optional description
+	CreatedAt         types.String `tfsdk:"created_at"`          // Timestamp
+	UpdatedAt         types.String `tfsdk:"updated_at"`          // Timestamp
+	ConnectionType    types.String `tfsdk:"connection_type"`     // OAUTH, SSH or GITHUB_APP
+	PrivateKey        types.String `tfsdk:"private_key"`         // SSH or GitHub App private key (write-only)
+	PrivateKeyVersion types.Int64  `tfsdk:"private_key_version"` // Changing it sends private_key again
+	Endpoint          types.String `tfsdk:"endpoint"`            // Base URL for self-hosted VCS
+	ApiUrl            types.String `tfsdk:"api_url"`             // API URL for self-hosted VCS
+	AppId             types.Int64  `tfsdk:"app_id"`              // GitHub App ID
+	InstallationId    types.Int64  `tfsdk:"installation_id"`     // GitHub App installation ID
 }
 
 // VCSAPIResponse represents the JSON structure returned by the API
@@ -58,6 +98,8 @@ type VCSAPIResponse struct {
 	ConnectionType string    `json:"connectionType"`
 	EndpointUrl    string    `json:"endpointUrl"`
 	ApiUrl         string    `json:"apiUrl"`
+	AppId          int64     `json:"appId"`
+	InstallationId int64     `json:"installationId"`
 }
 
 // VCSCreateRequest represents the JSON structure for creating a VCS
@@ -72,6 +114,8 @@ type VCSCreateRequest struct {
 	PrivateKey     string `json:"privateKey,omitempty"`
 	EndpointUrl    string `json:"endpointUrl,omitempty"`
 	ApiUrl         string `json:"apiUrl,omitempty"`
+	AppId          int64  `json:"appId,omitempty"`
+	InstallationId int64  `json:"installationId,omitempty"`
 }
 
 // VCSUpdateRequest represents the JSON structure for updating a VCS
@@ -86,6 +130,8 @@ type VCSUpdateRequest struct {
 	PrivateKey     string `json:"privateKey,omitempty"`
 	EndpointUrl    string `json:"endpointUrl,omitempty"`
 	ApiUrl         string `json:"apiUrl,omitempty"`
+	AppId          *int64 `json:"appId,omitempty"`
+	InstallationId *int64 `json:"installationId,omitempty"`
 }
 
 type VCSResource struct {
@@ -115,21 +161,31 @@ func (r *VCSResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Required:    true,
 			},
 			"vcs_type": schema.StringAttribute{
-				Description: "The type of VCS (e.g., github, gitlab, bitbucket).",
+				Description: "The type of VCS: github, gitlab, bitbucket or azure_devops.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(vcsTypeGitHub, vcsTypeGitLab, vcsTypeBitbucket, vcsTypeAzureDevOps),
+				},
 			},
 			"url": schema.StringAttribute{
-				Description: "The URL of the VCS instance.",
+				Description: "The URL of the VCS instance, e.g. https://github.com, or the URL of a self-hosted instance.",
 				Required:    true,
+				Validators: []validator.String{
+					httpURLValidator{},
+				},
 			},
 			"client_id": schema.StringAttribute{
-				Description: "The client ID for the VCS.",
-				Required:    true,
+				Description: "The client ID for the VCS. Required for OAUTH connections.",
+				Optional:    true,
+				Computed:    true,
 				Sensitive:   false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"client_secret": schema.StringAttribute{
-				Description: "The client secret token for the VCS.",
-				Required:    true,
+				Description: "The client secret token for the VCS. Required for OAUTH connections.",
+				Optional:    true,
 				Sensitive:   true,
 			},
 			"description": schema.StringAttribute{
@@ -147,28 +203,56 @@ func (r *VCSResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Computed:    true,
 			},
 			"connection_type": schema.StringAttribute{
-				Description: "Connection type for the VCS: OAUTH or SSH.",
+				Description: "Connection type for the VCS: OAUTH, SSH or GITHUB_APP.",
 				Optional:    true,
 				Computed:    true,
-				Default:     stringdefault.StaticString("OAUTH"),
+				Default:     stringdefault.StaticString(vcsConnectionOAuth),
 				Validators: []validator.String{
-					stringvalidator.OneOf("OAUTH", "SSH"),
+					stringvalidator.OneOf(vcsConnectionOAuth, vcsConnectionSSH, vcsConnectionGitHubApp),
 				},
 			},
 			"private_key": schema.StringAttribute{
-				Description: "Private key for SSH-based VCS authentication.",
+				Description: "Private key for SSH-based VCS authentication, or the PEM private key of the GitHub App for GITHUB_APP connections. Write-only: it is sent to the API but never stored in the plan or state, so a new key is only sent when private_key_version changes.",
 				Optional:    true,
 				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					privateKeyValidator{},
+				},
+			},
+			"private_key_version": schema.Int64Attribute{
+				Description: "Any value that changes when private_key is rotated, e.g. 1, 2, 3. Changing it sends the current private_key to the API.",
+				Optional:    true,
+			},
+			"app_id": schema.Int64Attribute{
+				Description: "The ID of the GitHub App. Required for GITHUB_APP connections.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"installation_id": schema.Int64Attribute{
+				Description: "The ID of the GitHub App installation on the account or organization owning the repositories. Required for GITHUB_APP connections.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
 			"endpoint": schema.StringAttribute{
-				Description: "Base URL for self-hosted VCS instances.",
+				Description: "Base URL for self-hosted VCS instances. Not allowed for cloud services such as github.com.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					httpURLValidator{},
+				},
 			},
 			"api_url": schema.StringAttribute{
-				Description: "API URL for self-hosted VCS instances.",
+				Description: "API URL for self-hosted VCS instances, e.g. https://github.example.com/api/v3. Required for self-hosted instances and not allowed for cloud services such as github.com.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					httpURLValidator{},
+				},
 			},
 		},
 	}
@@ -182,6 +266,171 @@ func (r *VCSResource) Configure(ctx context.Context, req resource.ConfigureReque
 	}
 }
 
+// vcsConnectionAttributes lists the attributes each connection type needs.
+// Attributes listed only for other connection types must not be set.
+var vcsConnectionAttributes = map[string][]string{
+	vcsConnectionOAuth:     {"client_id", "client_secret"},
+	vcsConnectionSSH:       {"private_key"},
+	vcsConnectionGitHubApp: {"app_id", "installation_id", "private_key"},
+}
+
+// vcsSelfHostedAttributes are only meaningful for self-hosted instances; the
+// platform knows the endpoints of the cloud services.
+var vcsSelfHostedAttributes = []string{"endpoint", "api_url"}
+
+// ValidateConfig checks the attributes required and forbidden by the
+// connection type and VCS type, so an inconsistent connection is rejected at
+// plan time rather than by the API. URLs and private keys are checked by the
+// attribute validators.
+func (r *VCSResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VCSResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ConnectionType.IsUnknown() {
+		validateVCSConnectionAttributes(data, resp)
+	}
+	if !data.VcsType.IsUnknown() && !data.URL.IsUnknown() {
+		validateVCSHostAttributes(data, resp)
+	}
+}
+
+func validateVCSConnectionAttributes(data VCSResourceModel, resp *resource.ValidateConfigResponse) {
+	connectionType := data.ConnectionType.ValueString()
+	if data.ConnectionType.IsNull() {
+		connectionType = vcsConnectionOAuth
+	}
+
+	isSet := map[string]bool{
+		"client_id":       !data.ClientId.IsNull(),
+		"client_secret":   !data.ClientSecret.IsNull(),
+		"private_key":     !data.PrivateKey.IsNull(),
+		"app_id":          !data.AppId.IsNull(),
+		"installation_id": !data.InstallationId.IsNull(),
+	}
+
+	required := map[string]bool{}
+	for _, name := range vcsConnectionAttributes[connectionType] {
+		required[name] = true
+		if !isSet[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing required attribute",
+				fmt.Sprintf("%s is required when connection_type is %q.", name, connectionType),
+			)
+		}
+	}
+	for _, other := range []string{vcsConnectionOAuth, vcsConnectionSSH, vcsConnectionGitHubApp} {
+		for _, name := range vcsConnectionAttributes[other] {
+			if isSet[name] && !required[name] {
+				required[name] = true // report each attribute once
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid attribute for connection type",
+					fmt.Sprintf("%s is not used when connection_type is %q. Remove it, or set connection_type = %q.", name, connectionType, other),
+				)
+			}
+		}
+	}
+
+	if data.VcsType.IsUnknown() || data.VcsType.IsNull() {
+		return
+	}
+	vcsType := data.VcsType.ValueString()
+	supported, ok := vcsTypeConnections[vcsType]
+	if !ok {
+		// Rejected by the vcs_type validator.
+		return
+	}
+	for _, c := range supported {
+		if c == connectionType {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("connection_type"),
+		"Invalid connection type",
+		fmt.Sprintf("connection_type %q is not supported with vcs_type %q. Use one of: %s.", connectionType, vcsType, strings.Join(supported, ", ")),
+	)
+}
+
+func validateVCSHostAttributes(data VCSResourceModel, resp *resource.ValidateConfigResponse) {
+	hosts, ok := vcsCloudHosts[data.VcsType.ValueString()]
+	if !ok {
+		return
+	}
+	u, err := url.Parse(data.URL.ValueString())
+	if err != nil || u.Host == "" {
+		// Rejected by the url validator.
+		return
+	}
+
+	isSet := map[string]bool{
+		"endpoint": !data.Endpoint.IsNull(),
+		"api_url":  !data.ApiUrl.IsNull(),
+	}
+	if vcsCloudHost(u.Hostname(), hosts) {
+		for _, name := range vcsSelfHostedAttributes {
+			if isSet[name] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid attribute for cloud VCS",
+					fmt.Sprintf("%s only applies to self-hosted instances; %s is a %s cloud service. Remove it.", name, u.Hostname(), data.VcsType.ValueString()),
+				)
+			}
+		}
+		return
+	}
+	for otherType, otherHosts := range vcsCloudHosts {
+		if vcsCloudHost(u.Hostname(), otherHosts) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("url"),
+				"VCS type does not match URL",
+				fmt.Sprintf("%s is the %s cloud service, but vcs_type is %q. Set vcs_type = %q, or use the URL of your %s instance.",
+					u.Hostname(), otherType, data.VcsType.ValueString(), otherType, data.VcsType.ValueString()),
+			)
+			return
+		}
+	}
+	if !isSet["api_url"] {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Missing required attribute",
+			fmt.Sprintf("api_url is required for self-hosted %s instances such as %s, e.g. %q. If this is the cloud service, set url to https://%s.",
+				data.VcsType.ValueString(), u.Hostname(), vcsExampleAPIURL(data.VcsType.ValueString(), u), hosts[0]),
+		)
+	}
+}
+
+// vcsCloudHost reports whether host is, or is a subdomain of, one of the cloud
+// hosts, e.g. "myorg.visualstudio.com" for Azure DevOps.
+func vcsCloudHost(host string, cloudHosts []string) bool {
+	host = strings.ToLower(host)
+	for _, h := range cloudHosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+// vcsExampleAPIURL suggests the usual API URL of a self-hosted instance.
+func vcsExampleAPIURL(vcsType string, u *url.URL) string {
+	base := u.Scheme + "://" + u.Host
+	switch vcsType {
+	case vcsTypeGitHub:
+		return base + "/api/v3"
+	case vcsTypeGitLab:
+		return base + "/api/v4"
+	case vcsTypeBitbucket:
+		return base + "/rest/api/1.0"
+	default:
+		return base
+	}
+}
+
 func (r *VCSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data VCSResourceModel
 	diags := req.Plan.Get(ctx, &data)
@@ -190,6 +439,12 @@ func (r *VCSResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	// private_key is write-only and only present in the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key"), &data.PrivateKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createReq := VCSCreateRequest{
 		Name:           data.Name.ValueString(),
 		VcsType:        data.VcsType.ValueString(),
@@ -208,6 +463,12 @@ func (r *VCSResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if !data.ApiUrl.IsNull() {
 		createReq.ApiUrl = data.ApiUrl.ValueString()
 	}
+	if !data.AppId.IsNull() {
+		createReq.AppId = data.AppId.ValueInt64()
+	}
+	if !data.InstallationId.IsNull() {
+		createReq.InstallationId = data.InstallationId.ValueInt64()
+	}
 
 	reqBody, err := json.Marshal(createReq)
 	if err != nil {
@@ -272,9 +533,11 @@ func (r *VCSResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if vcs.ConnectionType != "" {
 		data.ConnectionType = types.StringValue(vcs.ConnectionType)
 	}
-	// private_key is write-only, keep existing value in state
+	// private_key is write-only and not stored in state
 	data.Endpoint = types.StringValue(vcs.EndpointUrl)
 	data.ApiUrl = types.StringValue(vcs.ApiUrl)
+	data.AppId = optionalInt64(vcs.AppId)
+	data.InstallationId = optionalInt64(vcs.InstallationId)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -349,9 +612,11 @@ func (r *VCSResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	if vcs.ConnectionType != "" {
 		data.ConnectionType = types.StringValue(vcs.ConnectionType)
 	}
-	// private_key is write-only, keep existing value in state
+	// private_key is write-only and not stored in state
 	data.Endpoint = types.StringValue(vcs.EndpointUrl)
 	data.ApiUrl = types.StringValue(vcs.ApiUrl)
+	data.AppId = optionalInt64(vcs.AppId)
+	data.InstallationId = optionalInt64(vcs.InstallationId)
 
 	// Save (possibly updated) state
 	diags = resp.State.Set(ctx, &data)
@@ -403,8 +668,15 @@ func (r *VCSResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		updateReq.ConnectionType = plan.ConnectionType.ValueString()
 	}
 
-	if !plan.PrivateKey.Equal(state.PrivateKey) && !plan.PrivateKey.IsNull() {
-		updateReq.PrivateKey = plan.PrivateKey.ValueString()
+	// private_key is write-only, so a changed key cannot be detected. It is
+	// sent when private_key_version changes, or with a new connection type.
+	if !plan.PrivateKeyVersion.Equal(state.PrivateKeyVersion) || !plan.ConnectionType.Equal(state.ConnectionType) {
+		var privateKey types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key"), &privateKey)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		updateReq.PrivateKey = privateKey.ValueString()
 	}
 
 	if !plan.Endpoint.Equal(state.Endpoint) && !plan.Endpoint.IsNull() {
@@ -415,6 +687,16 @@ func (r *VCSResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		updateReq.ApiUrl = plan.ApiUrl.ValueString()
 	}
 
+	// Removing app_id or installation_id, e.g. when leaving GITHUB_APP, sends
+	// 0 to clear the setting.
+	if !plan.AppId.Equal(state.AppId) {
+		updateReq.AppId = clearableInt64(plan.AppId)
+	}
+
+	if !plan.InstallationId.Equal(state.InstallationId) {
+		updateReq.InstallationId = clearableInt64(plan.InstallationId)
+	}
+
 	reqBody, err := json.Marshal(updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error marshaling request", err.Error())
@@ -476,9 +758,11 @@ func (r *VCSResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	plan.CreatedAt = types.StringValue(vcs.CreatedAt.Format(time.RFC3339))
 	plan.UpdatedAt = types.StringValue(vcs.UpdatedAt.Format(time.RFC3339))
 	plan.ConnectionType = types.StringValue(vcs.ConnectionType)
-	// private_key is write-only, keep value from plan
+	// private_key is write-only and not stored in state
 	plan.Endpoint = types.StringValue(vcs.EndpointUrl)
 	plan.ApiUrl = types.StringValue(vcs.ApiUrl)
+	plan.AppId = optionalInt64(vcs.AppId)
+	plan.InstallationId = optionalInt64(vcs.InstallationId)
 
 	// Save updated info
 	diags = resp.State.Set(ctx, &plan)
@@ -620,9 +904,11 @@ func (r *VCSResource) ImportState(ctx context.Context, req resource.ImportStateR
 	data.CreatedAt = types.StringValue(vcs.CreatedAt.Format(time.RFC3339))
 	data.UpdatedAt = types.StringValue(vcs.UpdatedAt.Format(time.RFC3339))
 	data.ConnectionType = types.StringValue(vcs.ConnectionType)
-	// private_key is write-only, not returned by API - will need to be set manually
+	// private_key is write-only, not returned by API - set private_key_version to send it
 	data.Endpoint = types.StringValue(vcs.EndpointUrl)
 	data.ApiUrl = types.StringValue(vcs.ApiUrl)
+	data.AppId = optionalInt64(vcs.AppId)
+	data.InstallationId = optionalInt64(vcs.InstallationId)
 
 	// Set the state
 	diags := resp.State.Set(ctx, &data)
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -56,6 +57,9 @@ type WorkspaceResourceModel struct {
 	TflintPlugins         types.List   `tfsdk:"tflint_plugins"`
 	SshId                 types.String `tfsdk:"ssh_id"`
 	ModuleSshKey          types.String `tfsdk:"module_ssh_key"`
+	ModelProviderID       types.String `tfsdk:"model_provider_id"`
+	Model                 types.String `tfsdk:"model"`
+	EffectiveSettings     types.Object `tfsdk:"effective_settings"`
 }
 
 // TriggerPatternModel is a single {pattern, enabled} element of the trigger_patterns list.
@@ -78,6 +82,61 @@ var triggerPatternAttrTypes = map[string]attr.Type{
 
 var triggerPatternObjectType = types.ObjectType{AttrTypes: triggerPatternAttrTypes}
 
+// Sources reported in effective_settings for where a value was resolved from.
+const (
+	settingSourceWorkspace    = "workspace"
+	settingSourceOrganization = "organization"
+)
+
+// effectiveSettingsFields lists the attributes of the effective_settings
+// object: each inheritable setting alongside the level it was resolved from.
+var effectiveSettingsFields = []struct {
+	name        string
+	attrType    attr.Type
+	description string
+}{
+	{"execution_mode", types.StringType, "The execution mode in effect."},
+	{"execution_mode_source", types.StringType, "Where execution_mode was resolved from."},
+	{"agents_enabled", types.BoolType, "Whether AI agents can run; requires agents to be enabled on both the organization and the workspace."},
+	{"agents_enabled_source", types.StringType, "Where agents_enabled was resolved from."},
+	{"drift_detection_enabled", types.BoolType, "Whether drift detection is in effect."},
+	{"drift_detection_enabled_source", types.StringType, "Where drift_detection_enabled was resolved from."},
+	{"remedy_drift", types.BoolType, "Whether drift is remedied automatically."},
+	{"remedy_drift_source", types.StringType, "Where remedy_drift was resolved from."},
+	{"auto_implement_changes", types.BoolType, "Whether changes are implemented automatically."},
+	{"auto_implement_changes_source", types.StringType, "Where auto_implement_changes was resolved from."},
+	{"model_provider_id", types.StringType, "ID of the model provider agents use; null when neither the workspace nor the organization selects one."},
+	{"model_provider_id_source", types.StringType, "Where model_provider_id was resolved from."},
+}
+
+// effectiveSettingsAttrTypes describes the effective_settings object.
+var effectiveSettingsAttrTypes = func() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(effectiveSettingsFields))
+	for _, f := range effectiveSettingsFields {
+		attrTypes[f.name] = f.attrType
+	}
+	return attrTypes
+}()
+
+// effectiveSettingsDescription is the description of the effective_settings
+// attribute on both the workspace resource and data source.
+const effectiveSettingsDescription = "Settings in effect for the workspace after resolving values inherited from the organization. Each setting has a companion '_source' attribute set to 'workspace' or 'organization'."
+
+// effectiveSettingsAttributes builds the computed effective_settings
+// attributes for either schema package; str and boolean construct a computed
+// string and bool attribute with the given description.
+func effectiveSettingsAttributes[A any](str, boolean func(description string) A) map[string]A {
+	attributes := make(map[string]A, len(effectiveSettingsFields))
+	for _, f := range effectiveSettingsFields {
+		if f.attrType == types.BoolType {
+			attributes[f.name] = boolean(f.description)
+		} else {
+			attributes[f.name] = str(f.description)
+		}
+	}
+	return attributes
+}
+
 type WorkspaceAPIResponse struct {
 	ID                    string           `json:"id"`
 	Name                  string           `json:"name"`
@@ -106,6 +165,8 @@ type WorkspaceAPIResponse struct {
 	TflintPlugins         []string         `json:"tflint_plugins"`
 	SshId                 string           `json:"ssh_id"`
 	ModuleSshKey          string           `json:"module_ssh_key"`
+	ModelProviderID       *string          `json:"model_provider_id"`
+	Model                 *string          `json:"model"`
 }
 
 type WorkspaceCreateRequest struct {
@@ -131,6 +192,8 @@ type WorkspaceCreateRequest struct {
 	TflintPlugins         []string         `json:"tflint_plugins,omitempty"`
 	SshId                 string           `json:"ssh_id,omitempty"`
 	ModuleSshKey          string           `json:"module_ssh_key,omitempty"`
+	ModelProviderID       string           `json:"model_provider_id,omitempty"`
+	Model                 string           `json:"model,omitempty"`
 }
 
 type WorkspaceUpdateRequest struct {
@@ -156,6 +219,8 @@ type WorkspaceUpdateRequest struct {
 	TflintPlugins         []string          `json:"tflint_plugins,omitempty"`
 	SshId                 string            `json:"ssh_id,omitempty"`
 	ModuleSshKey          string            `json:"module_ssh_key,omitempty"`
+	ModelProviderID       json.RawMessage   `json:"model_provider_id,omitempty"`
+	Model                 *string           `json:"model,omitempty"`
 }
 
 type WorkspaceResource struct {
@@ -319,7 +384,7 @@ func (r *WorkspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:    true,
 			},
 			"ssh_id": schema.StringAttribute{
-				Description: "ID of the SSH key to use for the workspace.",
+				Description: "ID of the SSH key to use for the workspace, e.g. the id of an infradots_ssh_key resource.",
 				Optional:    true,
 				Computed:    true,
 			},
@@ -328,6 +393,32 @@ func (r *WorkspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:    true,
 				Computed:    true,
 			},
+			"model_provider_id": schema.StringAttribute{
+				Description: "ID of the model provider agents use for this workspace, e.g. to keep a sensitive workspace on a self-hosted model. If null, inherits the organization's default_model_provider_id.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"model": schema.StringAttribute{
+				Description: "The model agents use for this workspace. If null, the model provider's default model is used.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"effective_settings": schema.SingleNestedAttribute{
+				Description: effectiveSettingsDescription,
+				Computed:    true,
+				Attributes: effectiveSettingsAttributes(
+					func(description string) schema.Attribute {
+						return schema.StringAttribute{Description: description, Computed: true}
+					},
+					func(description string) schema.Attribute {
+						return schema.BoolAttribute{Description: description, Computed: true}
+					},
+				),
+			},
 			"vcs": schema.SingleNestedAttribute{
 				Description: "VCS connection details associated with this workspace.",
 				Computed:    true,
@@ -482,6 +573,8 @@ func mapWorkspaceResponseToModel(ctx context.Context, data *WorkspaceResourceMod
 	}
 	data.SshId = types.StringValue(workspace.SshId)
 	data.ModuleSshKey = types.StringValue(workspace.ModuleSshKey)
+	data.ModelProviderID = optionalStringPointer(workspace.ModelProviderID)
+	data.Model = optionalStringPointer(workspace.Model)
 	if workspace.Tags != nil {
 		tagMap := map[string]attr.Value{}
 		for k, v := range workspace.Tags {
@@ -493,6 +586,102 @@ func mapWorkspaceResponseToModel(ctx context.Context, data *WorkspaceResourceMod
 	}
 }
 
+// inheritedBool resolves a workspace-level override against the organization value.
+func inheritedBool(workspaceValue *bool, orgValue bool) (bool, string) {
+	if workspaceValue != nil {
+		return *workspaceValue, settingSourceWorkspace
+	}
+	return orgValue, settingSourceOrganization
+}
+
+// resolveEffectiveSettings computes the effective_settings object for a
+// workspace from its own values and those of its organization.
+func resolveEffectiveSettings(workspace WorkspaceAPIResponse, org OrganizationAPIResponse) types.Object {
+	executionMode, executionModeSource := workspace.ExecutionMode, settingSourceWorkspace
+	if executionMode == "" {
+		executionMode, executionModeSource = org.ExecutionMode, settingSourceOrganization
+	}
+
+	// Agents must be allowed by the organization before the workspace flag
+	// has any effect.
+	agentsEnabled, agentsEnabledSource := workspace.AgentsEnabled, settingSourceWorkspace
+	if !org.AgentsEnabled {
+		agentsEnabled, agentsEnabledSource = false, settingSourceOrganization
+	}
+
+	drift, driftSource := inheritedBool(workspace.DriftDetectionEnabled, org.DriftDetectionEnabled)
+	remedy, remedySource := inheritedBool(workspace.RemedyDrift, org.RemedyDrift)
+	autoImplement, autoImplementSource := inheritedBool(workspace.AutoImplementChanges, org.AutoImplementChanges)
+
+	modelProvider, modelProviderSource := optionalStringPointer(workspace.ModelProviderID), settingSourceWorkspace
+	if modelProvider.IsNull() {
+		modelProvider, modelProviderSource = optionalStringPointer(org.DefaultModelProviderID), settingSourceOrganization
+	}
+
+	return types.ObjectValueMust(effectiveSettingsAttrTypes, map[string]attr.Value{
+		"execution_mode":                 types.StringValue(executionMode),
+		"execution_mode_source":          types.StringValue(executionModeSource),
+		"agents_enabled":                 types.BoolValue(agentsEnabled),
+		"agents_enabled_source":          types.StringValue(agentsEnabledSource),
+		"drift_detection_enabled":        types.BoolValue(drift),
+		"drift_detection_enabled_source": types.StringValue(driftSource),
+		"remedy_drift":                   types.BoolValue(remedy),
+		"remedy_drift_source":            types.StringValue(remedySource),
+		"auto_implement_changes":         types.BoolValue(autoImplement),
+		"auto_implement_changes_source":  types.StringValue(autoImplementSource),
+		"model_provider_id":              modelProvider,
+		"model_provider_id_source":       types.StringValue(modelProviderSource),
+	})
+}
+
+// fetchOrganization retrieves an organization by name.
+func fetchOrganization(p *InfradotsProvider, organizationName string) (*OrganizationAPIResponse, error) {
+	url := fmt.Sprintf("https://%s/api/organizations/%s/", p.host, organizationName)
+
+	reqHttp, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(reqHttp)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var org OrganizationAPIResponse
+	if err := json.Unmarshal(respBody, &org); err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+// effectiveSettingsFor fetches the organization and resolves the workspace's
+// effective settings. Failing to read the organization is not fatal: the
+// attribute is left null and a warning explains why.
+func effectiveSettingsFor(p *InfradotsProvider, organizationName string, workspace WorkspaceAPIResponse) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	org, err := fetchOrganization(p, organizationName)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to resolve effective workspace settings",
+			fmt.Sprintf("Reading organization '%s' failed, effective_settings is left empty: %s", organizationName, err),
+		)
+		return types.ObjectNull(effectiveSettingsAttrTypes), diags
+	}
+	return resolveEffectiveSettings(workspace, *org), diags
+}
+
 func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data WorkspaceResourceModel
 	diags := req.Plan.Get(ctx, &data)
@@ -581,6 +770,8 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 	if !data.ModuleSshKey.IsNull() {
 		createReq.ModuleSshKey = data.ModuleSshKey.ValueString()
 	}
+	createReq.ModelProviderID = data.ModelProviderID.ValueString()
+	createReq.Model = data.Model.ValueString()
 
 	reqBody, err := json.Marshal(createReq)
 	if err != nil {
@@ -632,6 +823,8 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	mapWorkspaceResponseToModel(ctx, &data, workspace)
+	data.EffectiveSettings, diags = effectiveSettingsFor(r.provider, data.OrganizationName.ValueString(), workspace)
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -691,6 +884,8 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	mapWorkspaceResponseToModel(ctx, &data, workspace)
+	data.EffectiveSettings, diags = effectiveSettingsFor(r.provider, data.OrganizationName.ValueString(), workspace)
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -823,6 +1018,14 @@ func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	if !plan.ModuleSshKey.Equal(state.ModuleSshKey) && !plan.ModuleSshKey.IsNull() {
 		updateReq.ModuleSshKey = plan.ModuleSshKey.ValueString()
 	}
+	// A removed model provider is cleared, so the workspace inherits the
+	// organization's default again.
+	if !plan.ModelProviderID.Equal(state.ModelProviderID) {
+		updateReq.ModelProviderID = nullableString(plan.ModelProviderID)
+	}
+	if !plan.Model.Equal(state.Model) {
+		updateReq.Model = clearableString(plan.Model)
+	}
 
 	reqBody, err := json.Marshal(updateReq)
 	if err != nil {
@@ -876,6 +1079,8 @@ func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	mapWorkspaceResponseToModel(ctx, &plan, workspace)
+	plan.EffectiveSettings, diags = effectiveSettingsFor(r.provider, plan.OrganizationName.ValueString(), workspace)
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -1006,7 +1211,10 @@ func (r *WorkspaceResource) ImportState(ctx context.Context, req resource.Import
 	var data WorkspaceResourceModel
 	data.OrganizationName = types.StringValue(organizationName)
 	mapWorkspaceResponseToModel(ctx, &data, *workspace)
+	effectiveSettings, diags := effectiveSettingsFor(r.provider, data.OrganizationName.ValueString(), *workspace)
+	resp.Diagnostics.Append(diags...)
+	data.EffectiveSettings = effectiveSettings
 
-	diags := resp.State.Set(ctx, &data)
+	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
@@ -8,10 +8,16 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,7 +25,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ resource.Resource = &WorkspaceIntegrationResource{}
+var (
+	_ resource.Resource               = &WorkspaceIntegrationResource{}
+	_ resource.ResourceWithModifyPlan = &WorkspaceIntegrationResource{}
+)
+
+// Events a workspace integration can be limited to.
+var workspaceIntegrationEvents = []string{
+	"run_started",
+	"run_succeeded",
+	"run_failed",
+	"drift_detected",
+	"approval_needed",
+	"policy_violation",
+}
+
+// notificationSeverities are ordered from least to most severe.
+var notificationSeverities = []string{"info", "warning", "error", "critical"}
 
 func NewWorkspaceIntegrationResource() resource.Resource {
 	return &WorkspaceIntegrationResource{}
@@ -30,13 +52,35 @@ type WorkspaceIntegrationResource struct {
 }
 
 type WorkspaceIntegrationResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	OrganizationName types.String `tfsdk:"organization_name"`
-	WorkspaceName    types.String `tfsdk:"workspace_name"`
-	IntegrationID    types.String `tfsdk:"integration_id"`
-	RunAfterStage    types.String `tfsdk:"run_after_stage"`
-	SlackChannels    types.List   `tfsdk:"slack_channels"`
-	SlackEnvChannels types.Map    `tfsdk:"slack_env_channels"`
+	ID                 types.String `tfsdk:"id"`
+	OrganizationName   types.String `tfsdk:"organization_name"`
+	WorkspaceName      types.String `tfsdk:"workspace_name"`
+	IntegrationID      types.String `tfsdk:"integration_id"`
+	RunAfterStage      types.String `tfsdk:"run_after_stage"`
+	SlackChannels      types.List   `tfsdk:"slack_channels"`
+	SlackEnvChannels   types.Map    `tfsdk:"slack_env_channels"`
+	WorkspaceTags      types.Map    `tfsdk:"workspace_tags"`
+	SelectedWorkspaces types.List   `tfsdk:"selected_workspaces"`
+	Events             types.List   `tfsdk:"events"`
+	MinSeverity        types.String `tfsdk:"min_severity"`
+	Routes             types.List   `tfsdk:"routes"`
+}
+
+type WorkspaceIntegrationRouteModel struct {
+	WorkspaceTags types.Map  `tfsdk:"workspace_tags"`
+	SlackChannels types.List `tfsdk:"slack_channels"`
+}
+
+var workspaceIntegrationRouteAttrTypes = map[string]attr.Type{
+	"workspace_tags": types.MapType{ElemType: types.StringType},
+	"slack_channels": types.ListType{ElemType: types.StringType},
+}
+
+// WorkspaceIntegrationRoute sends the notifications of workspaces whose tags
+// contain all of WorkspaceTags to SlackChannels.
+type WorkspaceIntegrationRoute struct {
+	WorkspaceTags map[string]string `json:"workspace_tags"`
+	SlackChannels []string          `json:"slack_channels"`
 }
 
 type WorkspaceIntegrationRef struct {
@@ -45,18 +89,24 @@ type WorkspaceIntegrationRef struct {
 }
 
 type WorkspaceIntegrationAPIResponse struct {
-	ID               string                  `json:"id"`
-	Integration      WorkspaceIntegrationRef `json:"integration"`
-	RunAfterStage    string                  `json:"run_after_stage"`
-	SlackChannels    []string                `json:"slack_channels"`
-	SlackEnvChannels map[string]string       `json:"slack_env_channels"`
+	ID               string                      `json:"id"`
+	Integration      WorkspaceIntegrationRef     `json:"integration"`
+	RunAfterStage    string                      `json:"run_after_stage"`
+	SlackChannels    []string                    `json:"slack_channels"`
+	SlackEnvChannels map[string]string           `json:"slack_env_channels"`
+	Events           []string                    `json:"events"`
+	MinSeverity      string                      `json:"min_severity"`
+	Routes           []WorkspaceIntegrationRoute `json:"routes"`
 }
 
 type WorkspaceIntegrationCreateRequest struct {
-	IntegrationID    string            `json:"integration_id"`
-	RunAfterStage    string            `json:"run_after_stage,omitempty"`
-	SlackChannels    []string          `json:"slack_channels,omitempty"`
-	SlackEnvChannels map[string]string `json:"slack_env_channels,omitempty"`
+	IntegrationID    string                      `json:"integration_id"`
+	RunAfterStage    string                      `json:"run_after_stage,omitempty"`
+	SlackChannels    []string                    `json:"slack_channels,omitempty"`
+	SlackEnvChannels map[string]string           `json:"slack_env_channels,omitempty"`
+	Events           []string                    `json:"events,omitempty"`
+	MinSeverity      string                      `json:"min_severity,omitempty"`
+	Routes           []WorkspaceIntegrationRoute `json:"routes,omitempty"`
 }
 
 func (r *WorkspaceIntegrationResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -79,8 +129,24 @@ func (r *WorkspaceIntegrationResource) Schema(_ context.Context, _ resource.Sche
 				Required:    true,
 			},
 			"workspace_name": schema.StringAttribute{
-				Description: "The name of the workspace.",
-				Required:    true,
+				Description: "The name of the workspace. Exactly one of workspace_name or workspace_tags must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("workspace_tags")),
+				},
+			},
+			"workspace_tags": schema.MapAttribute{
+				Description: "Attach the integration to every workspace whose tags contain all of these key/value pairs.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"selected_workspaces": schema.ListAttribute{
+				Description: "The workspaces the integration is attached to, resolved from workspace_name or workspace_tags at plan time.",
+				Computed:    true,
+				ElementType: types.StringType,
 			},
 			"integration_id": schema.StringAttribute{
 				Description: "The ID of the integration to attach.",
@@ -103,12 +169,71 @@ func (r *WorkspaceIntegrationResource) Schema(_ context.Context, _ resource.Sche
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"slack_env_channels": schema.MapAttribute{
 				Description: "Map of environment names to Slack channel names.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"events": schema.ListAttribute{
+				Description: "Only notify on these events: run_started, run_succeeded, run_failed, drift_detected, approval_needed, policy_violation. If not set, every event is notified.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(workspaceIntegrationEvents...)),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"min_severity": schema.StringAttribute{
+				Description: "Only notify on events of at least this severity: info, warning, error or critical. If not set, events of every severity are notified.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(notificationSeverities...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"routes": schema.ListNestedAttribute{
+				Description: "Send the notifications of workspaces with matching tags to other Slack channels. The first matching route wins; notifications of workspaces no route matches go to slack_channels.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"workspace_tags": schema.MapAttribute{
+							Description: "The route matches workspaces whose tags contain all of these key/value pairs.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Map{
+								mapvalidator.SizeAtLeast(1),
+							},
+						},
+						"slack_channels": schema.ListAttribute{
+							Description: "The Slack channels to notify for matching workspaces.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 	}
@@ -122,7 +247,9 @@ func (r *WorkspaceIntegrationResource) Configure(_ context.Context, req resource
 	}
 }
 
-func mapWorkspaceIntegrationToModel(_ context.Context, data *WorkspaceIntegrationResourceModel, wi WorkspaceIntegrationAPIResponse) {
+func mapWorkspaceIntegrationToModel(ctx context.Context, data *WorkspaceIntegrationResourceModel, wi WorkspaceIntegrationAPIResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	data.ID = types.StringValue(wi.ID)
 	data.IntegrationID = types.StringValue(wi.Integration.ID)
 	data.RunAfterStage = types.StringValue(wi.RunAfterStage)
@@ -146,16 +273,80 @@ func mapWorkspaceIntegrationToModel(_ context.Context, data *WorkspaceIntegratio
 	} else {
 		data.SlackEnvChannels = types.MapValueMust(types.StringType, map[string]attr.Value{})
 	}
+
+	var d diag.Diagnostics
+	data.Events, d = stringListOrNull(ctx, data.Events, wi.Events)
+	diags.Append(d...)
+
+	data.MinSeverity = types.StringNull()
+	if wi.MinSeverity != "" {
+		data.MinSeverity = types.StringValue(wi.MinSeverity)
+	}
+
+	data.Routes = types.ListNull(types.ObjectType{AttrTypes: workspaceIntegrationRouteAttrTypes})
+	if len(wi.Routes) > 0 {
+		routes := make([]WorkspaceIntegrationRouteModel, len(wi.Routes))
+		for i, route := range wi.Routes {
+			routes[i].WorkspaceTags, d = types.MapValueFrom(ctx, types.StringType, route.WorkspaceTags)
+			diags.Append(d...)
+			routes[i].SlackChannels, d = types.ListValueFrom(ctx, types.StringType, route.SlackChannels)
+			diags.Append(d...)
+		}
+		data.Routes, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workspaceIntegrationRouteAttrTypes}, routes)
+		diags.Append(d...)
+	}
+
+	return diags
 }
 
-func (r *WorkspaceIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data WorkspaceIntegrationResourceModel
-	diags := req.Plan.Get(ctx, &data)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+// findAttachment looks up the attachment of an integration in a workspace.
+// It returns nil when the workspace has no such attachment and the HTTP status
+// of the listing so callers can tell a missing workspace (404) apart.
+func (r *WorkspaceIntegrationResource) findAttachment(organizationName, workspaceName, integrationID string) (*WorkspaceIntegrationAPIResponse, int, error) {
+	url := fmt.Sprintf("https://%s/api/organizations/%s/workspaces/%s/integrations/",
+		r.provider.host,
+		organizationName,
+		workspaceName)
+
+	reqHttp, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
+
+	httpResp, err := r.provider.client.Do(reqHttp)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, httpResp.StatusCode, err
+	}
+
+	if httpResp.StatusCode != 200 {
+		return nil, httpResp.StatusCode, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
 	}
 
+	var integrations []WorkspaceIntegrationAPIResponse
+	if err := json.Unmarshal(respBody, &integrations); err != nil {
+		return nil, httpResp.StatusCode, err
+	}
+
+	for _, wi := range integrations {
+		if wi.Integration.ID == integrationID {
+			return &wi, httpResp.StatusCode, nil
+		}
+	}
+	return nil, httpResp.StatusCode, nil
+}
+
+// attach attaches the integration to a single workspace with the settings of
+// data and returns the resulting attachment.
+func (r *WorkspaceIntegrationResource) attach(ctx context.Context, data *WorkspaceIntegrationResourceModel, workspaceName string) (*WorkspaceIntegrationAPIResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	createReq := WorkspaceIntegrationCreateRequest{
 		IntegrationID: data.IntegrationID.ValueString(),
 		RunAfterStage: data.RunAfterStage.ValueString(),
@@ -163,126 +354,208 @@ func (r *WorkspaceIntegrationResource) Create(ctx context.Context, req resource.
 
 	if !data.SlackChannels.IsNull() && !data.SlackChannels.IsUnknown() {
 		var channels []string
-		diags = data.SlackChannels.ElementsAs(ctx, &channels, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(data.SlackChannels.ElementsAs(ctx, &channels, false)...)
+		if diags.HasError() {
+			return nil, diags
 		}
 		createReq.SlackChannels = channels
 	}
 
 	if !data.SlackEnvChannels.IsNull() && !data.SlackEnvChannels.IsUnknown() {
 		var envChannels map[string]string
-		diags = data.SlackEnvChannels.ElementsAs(ctx, &envChannels, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(data.SlackEnvChannels.ElementsAs(ctx, &envChannels, false)...)
+		if diags.HasError() {
+			return nil, diags
 		}
 		createReq.SlackEnvChannels = envChannels
 	}
 
+	if !data.Events.IsNull() && !data.Events.IsUnknown() {
+		diags.Append(data.Events.ElementsAs(ctx, &createReq.Events, false)...)
+	}
+	createReq.MinSeverity = data.MinSeverity.ValueString()
+	if !data.Routes.IsNull() && !data.Routes.IsUnknown() {
+		var routes []WorkspaceIntegrationRouteModel
+		diags.Append(data.Routes.ElementsAs(ctx, &routes, false)...)
+		for _, route := range routes {
+			var apiRoute WorkspaceIntegrationRoute
+			diags.Append(route.WorkspaceTags.ElementsAs(ctx, &apiRoute.WorkspaceTags, false)...)
+			diags.Append(route.SlackChannels.ElementsAs(ctx, &apiRoute.SlackChannels, false)...)
+			createReq.Routes = append(createReq.Routes, apiRoute)
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	reqBody, err := json.Marshal(createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request", err.Error())
-		return
+		diags.AddError("Error marshaling request", err.Error())
+		return nil, diags
 	}
 
 	url := fmt.Sprintf("https://%s/api/organizations/%s/workspaces/%s/integrations/%s/attach/",
 		r.provider.host,
 		data.OrganizationName.ValueString(),
-		data.WorkspaceName.ValueString(),
+		workspaceName,
 		data.IntegrationID.ValueString())
 
 	reqHttp, err := http.NewRequest(http.MethodPost, url, strings.NewReader(string(reqBody)))
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
+		diags.AddError("Error creating request", err.Error())
+		return nil, diags
 	}
 	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
 	reqHttp.Header.Set("Content-Type", "application/json")
 
 	httpResp, err := r.provider.client.Do(reqHttp)
 	if err != nil {
-		resp.Diagnostics.AddError("HTTP request failed", err.Error())
-		return
+		diags.AddError("HTTP request failed", err.Error())
+		return nil, diags
 	}
 	defer httpResp.Body.Close()
 
 	respBody, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading response body", err.Error())
-		return
+		diags.AddError("Error reading response body", err.Error())
+		return nil, diags
 	}
 
 	if httpResp.StatusCode != 200 && httpResp.StatusCode != 201 {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Create failed",
-			fmt.Sprintf("Status: %d, Body: %s", httpResp.StatusCode, string(respBody)),
+			fmt.Sprintf("Workspace: %s, Status: %d, Body: %s", workspaceName, httpResp.StatusCode, string(respBody)),
 		)
-		return
+		return nil, diags
 	}
 
 	// The attach endpoint may return an empty body on success; fall back to a GET.
-	var wi WorkspaceIntegrationAPIResponse
 	if len(respBody) > 0 {
-		err = json.Unmarshal(respBody, &wi)
-		if err != nil {
-			resp.Diagnostics.AddError("Error parsing response", err.Error())
-			return
+		var wi WorkspaceIntegrationAPIResponse
+		if err := json.Unmarshal(respBody, &wi); err != nil {
+			diags.AddError("Error parsing response", err.Error())
+			return nil, diags
 		}
-		mapWorkspaceIntegrationToModel(ctx, &data, wi)
-	} else {
-		listURL := fmt.Sprintf("https://%s/api/organizations/%s/workspaces/%s/integrations/",
-			r.provider.host,
-			data.OrganizationName.ValueString(),
-			data.WorkspaceName.ValueString())
+		return &wi, diags
+	}
 
-		listReq, err := http.NewRequest(http.MethodGet, listURL, nil)
-		if err != nil {
-			resp.Diagnostics.AddError("Error creating request", err.Error())
-			return
-		}
-		listReq.Header.Set("Authorization", "Bearer "+r.provider.token)
+	wi, _, err := r.findAttachment(data.OrganizationName.ValueString(), workspaceName, data.IntegrationID.ValueString())
+	if err != nil {
+		diags.AddError("Failed to read integration after attach", err.Error())
+		return nil, diags
+	}
+	if wi == nil {
+		diags.AddError("Integration not found after attach",
+			fmt.Sprintf("Integration %s not found in workspace %s", data.IntegrationID.ValueString(), workspaceName))
+		return nil, diags
+	}
+	return wi, diags
+}
 
-		listResp, err := r.provider.client.Do(listReq)
-		if err != nil {
-			resp.Diagnostics.AddError("HTTP request failed", err.Error())
-			return
-		}
-		defer listResp.Body.Close()
+// detach removes the integration from a single workspace. A workspace that no
+// longer exists has nothing to detach.
+func (r *WorkspaceIntegrationResource) detach(data *WorkspaceIntegrationResourceModel, workspaceName string) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-		listBody, err := io.ReadAll(listResp.Body)
-		if err != nil {
-			resp.Diagnostics.AddError("Error reading response body", err.Error())
-			return
-		}
+	url := fmt.Sprintf("https://%s/api/organizations/%s/workspaces/%s/integrations/%s/detach/",
+		r.provider.host,
+		data.OrganizationName.ValueString(),
+		workspaceName,
+		data.IntegrationID.ValueString())
 
-		if listResp.StatusCode != 200 {
-			resp.Diagnostics.AddError("Failed to read integration after attach",
-				fmt.Sprintf("Status: %d, Body: %s", listResp.StatusCode, string(listBody)))
-			return
-		}
+	reqHttp, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		diags.AddError("Error creating request", err.Error())
+		return diags
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
 
-		var integrations []WorkspaceIntegrationAPIResponse
-		if err = json.Unmarshal(listBody, &integrations); err != nil {
-			resp.Diagnostics.AddError("Error parsing integrations list", err.Error())
-			return
-		}
+	httpResp, err := r.provider.client.Do(reqHttp)
+	if err != nil {
+		diags.AddError("HTTP request failed", err.Error())
+		return diags
+	}
+	defer httpResp.Body.Close()
 
-		found := false
-		for _, item := range integrations {
-			if item.Integration.ID == data.IntegrationID.ValueString() {
-				mapWorkspaceIntegrationToModel(ctx, &data, item)
-				found = true
-				break
-			}
-		}
-		if !found {
-			resp.Diagnostics.AddError("Integration not found after attach",
-				fmt.Sprintf("Integration %s not found in workspace %s", data.IntegrationID.ValueString(), data.WorkspaceName.ValueString()))
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Error reading response body", err.Error())
+		return diags
+	}
+
+	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 && httpResp.StatusCode != 404 {
+		diags.AddError(
+			"Delete failed",
+			fmt.Sprintf("Workspace: %s, Status code: %d, Body: %s", workspaceName, httpResp.StatusCode, string(respBody)),
+		)
+	}
+	return diags
+}
+
+// workspaces returns the workspaces the integration is attached to and the
+// selected_workspaces value to store for them.
+func (r *WorkspaceIntegrationResource) workspaces(ctx context.Context, data *WorkspaceIntegrationResourceModel) ([]string, types.List, diag.Diagnostics) {
+	if !data.WorkspaceTags.IsNull() {
+		return selectedWorkspaceNames(ctx, r.provider, data.OrganizationName, data.WorkspaceTags, data.SelectedWorkspaces, "")
+	}
+	list, diags := types.ListValueFrom(ctx, types.StringType, []string{data.WorkspaceName.ValueString()})
+	return []string{data.WorkspaceName.ValueString()}, list, diags
+}
+
+// setTagSelectedID gives a tag-selected attachment a stable ID; it spans
+// several workspace attachments, so no single attachment ID fits.
+func setTagSelectedID(data *WorkspaceIntegrationResourceModel) {
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s:tags:%s",
+		data.OrganizationName.ValueString(),
+		data.IntegrationID.ValueString(),
+		workspaceSelectorString(workspaceSelectorFromMap(data.WorkspaceTags))))
+}
+
+// setUnknownSettingsEmpty replaces computed settings that are still unknown,
+// which happens when a tag selector matched no workspace to read them from.
+func setUnknownSettingsEmpty(data *WorkspaceIntegrationResourceModel) {
+	if data.SlackChannels.IsUnknown() {
+		data.SlackChannels = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+	if data.SlackEnvChannels.IsUnknown() {
+		data.SlackEnvChannels = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+}
+
+func (r *WorkspaceIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkspaceIntegrationResourceModel
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaces, selected, diags := r.workspaces(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var first *WorkspaceIntegrationAPIResponse
+	for _, ws := range workspaces {
+		wi, diags := r.attach(ctx, &data, ws)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
+		if first == nil {
+			first = wi
+		}
+	}
+
+	if first != nil {
+		resp.Diagnostics.Append(mapWorkspaceIntegrationToModel(ctx, &data, *first)...)
+	}
+	if !data.WorkspaceTags.IsNull() {
+		setTagSelectedID(&data)
+		setUnknownSettingsEmpty(&data)
 	}
+	data.SelectedWorkspaces = selected
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -296,69 +569,146 @@ func (r *WorkspaceIntegrationResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	url := fmt.Sprintf("https://%s/api/organizations/%s/workspaces/%s/integrations/",
-		r.provider.host,
-		data.OrganizationName.ValueString(),
-		data.WorkspaceName.ValueString())
-
-	reqHttp, err := http.NewRequest(http.MethodGet, url, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
+	if !data.WorkspaceTags.IsNull() {
+		r.readSelectedWorkspaces(ctx, &data, resp)
 		return
 	}
-	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
 
-	httpResp, err := r.provider.client.Do(reqHttp)
+	wi, statusCode, err := r.findAttachment(
+		data.OrganizationName.ValueString(),
+		data.WorkspaceName.ValueString(),
+		data.IntegrationID.ValueString())
+	if statusCode == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("HTTP request failed", err.Error())
+		resp.Diagnostics.AddError("Read failed", err.Error())
 		return
 	}
-	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode == 404 {
+	if wi == nil {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	if httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError("Read failed", fmt.Sprintf("Status code: %d", httpResp.StatusCode))
+	resp.Diagnostics.Append(mapWorkspaceIntegrationToModel(ctx, &data, *wi)...)
+	_, data.SelectedWorkspaces, diags = r.workspaces(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// readSelectedWorkspaces refreshes a tag-selected attachment. Only the
+// previously selected workspaces that still have the integration attached are
+// kept, so the next plan re-attaches it wherever it was removed or newly
+// matches.
+func (r *WorkspaceIntegrationResource) readSelectedWorkspaces(ctx context.Context, data *WorkspaceIntegrationResourceModel, resp *resource.ReadResponse) {
+	var selected []string
+	if !data.SelectedWorkspaces.IsNull() && !data.SelectedWorkspaces.IsUnknown() {
+		diags := data.SelectedWorkspaces.ElementsAs(ctx, &selected, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	attached := []string{}
+	for _, ws := range selected {
+		wi, statusCode, err := r.findAttachment(data.OrganizationName.ValueString(), ws, data.IntegrationID.ValueString())
+		if statusCode == 404 {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Read failed", err.Error())
+			return
+		}
+		if wi == nil {
+			continue
+		}
+		if len(attached) == 0 {
+			resp.Diagnostics.Append(mapWorkspaceIntegrationToModel(ctx, data, *wi)...)
+		}
+		attached = append(attached, ws)
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, attached)
+	resp.Diagnostics.Append(diags...)
+	data.SelectedWorkspaces = list
+	setTagSelectedID(data)
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only moves the attachment between workspaces: it detaches the
+// integration from workspaces that are no longer selected and attaches it to
+// newly selected ones. Attachment settings cannot be changed in place.
+func (r *WorkspaceIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state WorkspaceIntegrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	respBody, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading response body", err.Error())
+	var plan WorkspaceIntegrationResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var integrations []WorkspaceIntegrationAPIResponse
-	err = json.Unmarshal(respBody, &integrations)
-	if err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
+	if !plan.OrganizationName.Equal(state.OrganizationName) ||
+		!plan.RunAfterStage.Equal(state.RunAfterStage) ||
+		!plan.SlackChannels.Equal(state.SlackChannels) ||
+		!plan.SlackEnvChannels.Equal(state.SlackEnvChannels) ||
+		!plan.Events.Equal(state.Events) ||
+		!plan.MinSeverity.Equal(state.MinSeverity) ||
+		!plan.Routes.Equal(state.Routes) {
+		resp.Diagnostics.AddError("Update not supported", "infradots_workspace_integration only supports changing the selected workspaces; other fields are ForceNew.")
 		return
 	}
 
-	found := false
-	for _, wi := range integrations {
-		if wi.Integration.ID == data.IntegrationID.ValueString() {
-			mapWorkspaceIntegrationToModel(ctx, &data, wi)
-			found = true
-			break
+	previous, _, diags := r.workspaces(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	workspaces, selected, diags := r.workspaces(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, ws := range stringSetDifference(previous, workspaces) {
+		resp.Diagnostics.Append(r.detach(&state, ws)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if !found {
-		resp.State.RemoveResource(ctx)
-		return
+	var first *WorkspaceIntegrationAPIResponse
+	for _, ws := range stringSetDifference(workspaces, previous) {
+		wi, diags := r.attach(ctx, &plan, ws)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if first == nil {
+			first = wi
+		}
 	}
 
-	diags = resp.State.Set(ctx, &data)
-	resp.Diagnostics.Append(diags...)
-}
+	if plan.WorkspaceTags.IsNull() {
+		if first != nil {
+			resp.Diagnostics.Append(mapWorkspaceIntegrationToModel(ctx, &plan, *first)...)
+		}
+	} else {
+		setTagSelectedID(&plan)
+	}
+	plan.SelectedWorkspaces = selected
 
-// Update is not supported; all fields are immutable or ForceNew.
-func (r *WorkspaceIntegrationResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Update not supported", "infradots_workspace_integration does not support updates; all fields are ForceNew.")
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *WorkspaceIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -369,41 +719,44 @@ func (r *WorkspaceIntegrationResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	url := fmt.Sprintf("https://%s/api/organizations/%s/workspaces/%s/integrations/%s/detach/",
-		r.provider.host,
-		data.OrganizationName.ValueString(),
-		data.WorkspaceName.ValueString(),
-		data.IntegrationID.ValueString())
-
-	reqHttp, err := http.NewRequest(http.MethodDelete, url, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
+	workspaces, _, diags := r.workspaces(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	reqHttp.Header.Set("Authorization", "Bearer "+r.provider.token)
 
-	httpResp, err := r.provider.client.Do(reqHttp)
-	if err != nil {
-		resp.Diagnostics.AddError("HTTP request failed", err.Error())
+	for _, ws := range workspaces {
+		resp.Diagnostics.Append(r.detach(&data, ws)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ModifyPlan resolves workspace_tags into selected_workspaces so the plan
+// shows every workspace the integration is attached to.
+func (r *WorkspaceIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.provider == nil {
 		return
 	}
-	defer httpResp.Body.Close()
 
-	respBody, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading response body", err.Error())
+	var plan WorkspaceIntegrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if httpResp.StatusCode != 200 && httpResp.StatusCode != 204 {
-		resp.Diagnostics.AddError(
-			"Delete failed",
-			fmt.Sprintf("Status code: %d, Body: %s", httpResp.StatusCode, string(respBody)),
-		)
+	selected, diags := planWorkspaceSelection(ctx, r.provider, plan.OrganizationName, plan.WorkspaceName, plan.WorkspaceTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.State.RemoveResource(ctx)
+	diags = resp.Plan.SetAttribute(ctx, path.Root("selected_workspaces"), selected)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *WorkspaceIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -465,11 +818,13 @@ func (r *WorkspaceIntegrationResource) ImportState(ctx context.Context, req reso
 	var data WorkspaceIntegrationResourceModel
 	data.OrganizationName = types.StringValue(organizationName)
 	data.WorkspaceName = types.StringValue(workspaceName)
+	data.WorkspaceTags = types.MapNull(types.StringType)
+	data.SelectedWorkspaces = types.ListValueMust(types.StringType, []attr.Value{types.StringValue(workspaceName)})
 
 	found := false
 	for _, wi := range integrations {
 		if wi.Integration.ID == integrationID {
-			mapWorkspaceIntegrationToModel(ctx, &data, wi)
+			resp.Diagnostics.Append(mapWorkspaceIntegrationToModel(ctx, &data, wi)...)
 			found = true
 			break
 		}
@@ -0,0 +1,244 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWorkspaceIntegrationRoundTripper serves a tagged workspace list and
+// records which workspaces the integration is attached to and detached from.
+type MockWorkspaceIntegrationRoundTripper struct {
+	attached []string
+	detached []string
+	lastBody WorkspaceIntegrationCreateRequest
+}
+
+func (m *MockWorkspaceIntegrationRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Header:     make(http.Header),
+		Request:    req,
+		StatusCode: http.StatusOK,
+	}
+	resp.Header.Set("Content-Type", "application/json")
+
+	// /api/organizations/test-org/workspaces/{ws}/integrations/{id}/attach/
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+
+	switch {
+	case req.Method == http.MethodGet && req.URL.Path == "/api/organizations/test-org/workspaces/":
+		resp.Body = io.NopCloser(strings.NewReader(`[
+			{"id": "1", "name": "net-prod", "tags": {"env": "prod"}},
+			{"id": "2", "name": "app-prod", "tags": {"env": "prod"}},
+			{"id": "3", "name": "app-dev", "tags": {"env": "dev"}}
+		]`))
+	case req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/attach/"):
+		m.attached = append(m.attached, parts[4])
+		body, _ := io.ReadAll(req.Body)
+		m.lastBody = WorkspaceIntegrationCreateRequest{}
+		_ = json.Unmarshal(body, &m.lastBody)
+		filters, _ := json.Marshal(map[string]interface{}{
+			"events":       m.lastBody.Events,
+			"min_severity": m.lastBody.MinSeverity,
+			"routes":       m.lastBody.Routes,
+		})
+		resp.StatusCode = http.StatusCreated
+		resp.Body = io.NopCloser(strings.NewReader(`{
+			"id": "wi-` + parts[4] + `",
+			"integration": {"id": "int-1", "name": "alerts"},
+			"run_after_stage": "apply",
+			"slack_channels": ["#alerts"],
+			"slack_env_channels": {},
+			` + strings.Trim(string(filters), "{}") + `
+		}`))
+	case req.Method == http.MethodDelete && strings.HasSuffix(req.URL.Path, "/detach/"):
+		m.detached = append(m.detached, parts[4])
+		resp.StatusCode = http.StatusNoContent
+		resp.Body = io.NopCloser(strings.NewReader(""))
+	default:
+		resp.StatusCode = http.StatusNotFound
+		resp.Body = io.NopCloser(strings.NewReader(`{"error": "Not found"}`))
+	}
+	return resp, nil
+}
+
+func setupTestWorkspaceIntegrationResource(t *testing.T) (*WorkspaceIntegrationResource, *MockWorkspaceIntegrationRoundTripper) {
+	t.Helper()
+	mock := &MockWorkspaceIntegrationRoundTripper{}
+	provider := &InfradotsProvider{
+		host:   "api.infradots.com",
+		token:  "test-token",
+		client: &http.Client{Transport: mock},
+	}
+	return &WorkspaceIntegrationResource{provider: provider}, mock
+}
+
+func tagWorkspaceIntegrationModel(selected types.List) WorkspaceIntegrationResourceModel {
+	return WorkspaceIntegrationResourceModel{
+		ID:                 types.StringUnknown(),
+		OrganizationName:   types.StringValue("test-org"),
+		WorkspaceName:      types.StringNull(),
+		IntegrationID:      types.StringValue("int-1"),
+		RunAfterStage:      types.StringValue("apply"),
+		SlackChannels:      types.ListUnknown(types.StringType),
+		SlackEnvChannels:   types.MapUnknown(types.StringType),
+		WorkspaceTags:      types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")}),
+		SelectedWorkspaces: selected,
+		Events:             types.ListNull(types.StringType),
+		MinSeverity:        types.StringNull(),
+		Routes:             types.ListNull(types.ObjectType{AttrTypes: workspaceIntegrationRouteAttrTypes}),
+	}
+}
+
+func TestWorkspaceIntegrationResource_ModifyPlan_WorkspaceTags(t *testing.T) {
+	r, _ := setupTestWorkspaceIntegrationResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	require.Empty(t, plan.Set(ctx, tagWorkspaceIntegrationModel(types.ListUnknown(types.StringType))))
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	var selected []string
+	require.Empty(t, resp.Plan.GetAttribute(ctx, path.Root("selected_workspaces"), &selected))
+	assert.Equal(t, []string{"app-prod", "net-prod"}, selected)
+}
+
+func TestWorkspaceIntegrationResource_Create_WorkspaceTags(t *testing.T) {
+	r, mock := setupTestWorkspaceIntegrationResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	request := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, request.Plan.Set(ctx, tagWorkspaceIntegrationModel(types.ListUnknown(types.StringType))))
+
+	response := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	assert.Equal(t, []string{"app-prod", "net-prod"}, mock.attached)
+
+	var state WorkspaceIntegrationResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "test-org:int-1:tags:env=prod", state.ID.ValueString())
+	assert.Equal(t, 1, len(state.SlackChannels.Elements()))
+	assert.Equal(t, 2, len(state.SelectedWorkspaces.Elements()))
+}
+
+func TestWorkspaceIntegrationResource_Create_EventFilters(t *testing.T) {
+	r, mock := setupTestWorkspaceIntegrationResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := tagWorkspaceIntegrationModel(types.ListUnknown(types.StringType))
+	plan.Events = types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("run_failed"),
+		types.StringValue("drift_detected"),
+	})
+	plan.MinSeverity = types.StringValue("error")
+	plan.Routes = types.ListValueMust(types.ObjectType{AttrTypes: workspaceIntegrationRouteAttrTypes}, []attr.Value{
+		types.ObjectValueMust(workspaceIntegrationRouteAttrTypes, map[string]attr.Value{
+			"workspace_tags": types.MapValueMust(types.StringType, map[string]attr.Value{"tier": types.StringValue("network")}),
+			"slack_channels": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("#net-alerts")}),
+		}),
+	})
+
+	request := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, request.Plan.Set(ctx, plan))
+
+	response := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics.Errors())
+
+	assert.Equal(t, []string{"run_failed", "drift_detected"}, mock.lastBody.Events)
+	assert.Equal(t, "error", mock.lastBody.MinSeverity)
+	assert.Equal(t, []WorkspaceIntegrationRoute{
+		{WorkspaceTags: map[string]string{"tier": "network"}, SlackChannels: []string{"#net-alerts"}},
+	}, mock.lastBody.Routes)
+
+	var state WorkspaceIntegrationResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.True(t, state.Events.Equal(plan.Events))
+	assert.Equal(t, "error", state.MinSeverity.ValueString())
+	assert.True(t, state.Routes.Equal(plan.Routes))
+}
+
+func TestWorkspaceIntegrationResource_Update_SelectionChanged(t *testing.T) {
+	r, mock := setupTestWorkspaceIntegrationResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tagWorkspaceIntegrationModel(types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("app-prod"), types.StringValue("old-prod"),
+	}))
+	state.ID = types.StringValue("test-org:int-1:tags:env=prod")
+	state.SlackChannels = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("#alerts")})
+	state.SlackEnvChannels = types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	plan := state
+	plan.SelectedWorkspaces = types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("app-prod"), types.StringValue("net-prod"),
+	})
+
+	request := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	require.Empty(t, request.Plan.Set(ctx, plan))
+	require.Empty(t, request.State.Set(ctx, state))
+
+	response := resource.UpdateResponse{State: request.State}
+	r.Update(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	assert.Equal(t, []string{"net-prod"}, mock.attached)
+	assert.Equal(t, []string{"old-prod"}, mock.detached)
+}
+
+func TestWorkspaceIntegrationResource_Delete_WorkspaceTags(t *testing.T) {
+	r, mock := setupTestWorkspaceIntegrationResource(t)
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tagWorkspaceIntegrationModel(types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("app-prod"), types.StringValue("net-prod"),
+	}))
+	state.ID = types.StringValue("test-org:int-1:tags:env=prod")
+	state.SlackChannels = types.ListValueMust(types.StringType, []attr.Value{})
+	state.SlackEnvChannels = types.MapValueMust(types.StringType, map[string]attr.Value{})
+
+	request := resource.DeleteRequest{State: tfsdk.State{Schema: schemaResp.Schema}}
+	require.Empty(t, request.State.Set(ctx, state))
+
+	response := resource.DeleteResponse{State: request.State}
+	r.Delete(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	sort.Strings(mock.detached)
+	assert.Equal(t, []string{"app-prod", "net-prod"}, mock.detached)
+}
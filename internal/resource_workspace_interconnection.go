@@ -8,8 +8,11 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,8 +24,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &WorkspaceInterconnectionResource{}
-	_ resource.ResourceWithConfigure = &WorkspaceInterconnectionResource{}
+	_ resource.Resource               = &WorkspaceInterconnectionResource{}
+	_ resource.ResourceWithConfigure  = &WorkspaceInterconnectionResource{}
+	_ resource.ResourceWithModifyPlan = &WorkspaceInterconnectionResource{}
 )
 
 func NewWorkspaceInterconnectionResource() resource.Resource {
@@ -35,6 +39,7 @@ type WorkspaceInterconnectionResourceModel struct {
 	WorkspaceName    types.String `tfsdk:"workspace_name"`
 	ConnectedTo      types.List   `tfsdk:"connected_to"`
 	Condition        types.String `tfsdk:"condition"`
+	WorkspaceTags    types.Map    `tfsdk:"workspace_tags"`
 }
 
 type InterconnectionListResponse struct {
@@ -86,9 +91,19 @@ func (r *WorkspaceInterconnectionResource) Schema(_ context.Context, _ resource.
 				Required:    true,
 			},
 			"connected_to": schema.ListAttribute{
-				Description: "List of workspace names that this workspace triggers.",
+				Description: "List of workspace names that this workspace triggers. Exactly one of connected_to or workspace_tags must be set; with workspace_tags it is resolved at plan time.",
 				ElementType: types.StringType,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
+			},
+			"workspace_tags": schema.MapAttribute{
+				Description: "Trigger every workspace whose tags contain all of these key/value pairs. The source workspace itself is never included.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ExactlyOneOf(path.MatchRoot("connected_to")),
+				},
 			},
 			"condition": schema.StringAttribute{
 				Description: "Condition for triggering connected workspaces: full_apply or always.",
@@ -119,13 +134,22 @@ func (r *WorkspaceInterconnectionResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	var connectedTo []string
-	diags = data.ConnectedTo.ElementsAs(ctx, &connectedTo, false)
+	connectedTo, diags := r.connectedTo(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	data.ID = types.StringValue(data.OrganizationName.ValueString() + ":" + data.WorkspaceName.ValueString())
+
+	// A tag selector may match no workspace yet; there is nothing to connect.
+	if len(connectedTo) == 0 {
+		data.ConnectedTo = types.ListValueMust(types.StringType, []attr.Value{})
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	connectReq := InterconnectionConnectRequest{
 		Workspaces: connectedTo,
 		Condition:  data.Condition.ValueString(),
@@ -171,8 +195,6 @@ func (r *WorkspaceInterconnectionResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	data.ID = types.StringValue(data.OrganizationName.ValueString() + ":" + data.WorkspaceName.ValueString())
-
 	// Read back the actual state
 	r.readInterconnection(ctx, &data, resp)
 	if resp.Diagnostics.HasError() {
@@ -234,6 +256,20 @@ func (r *WorkspaceInterconnectionResource) readInterconnection(ctx context.Conte
 	data.ID = types.StringValue(data.OrganizationName.ValueString() + ":" + data.WorkspaceName.ValueString())
 }
 
+// connectedTo returns the workspaces to connect. With workspace_tags the
+// planned selection is used when known and expanded now otherwise.
+func (r *WorkspaceInterconnectionResource) connectedTo(ctx context.Context, data *WorkspaceInterconnectionResourceModel) ([]string, diag.Diagnostics) {
+	if !data.WorkspaceTags.IsNull() {
+		names, selected, diags := selectedWorkspaceNames(ctx, r.provider, data.OrganizationName, data.WorkspaceTags, data.ConnectedTo, data.WorkspaceName.ValueString())
+		data.ConnectedTo = selected
+		return names, diags
+	}
+
+	var names []string
+	diags := data.ConnectedTo.ElementsAs(ctx, &names, false)
+	return names, diags
+}
+
 func addDiagError(resp interface{}, summary, detail string) {
 	switch r := resp.(type) {
 	case *resource.CreateResponse:
@@ -258,8 +294,9 @@ func (r *WorkspaceInterconnectionResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	// If no connected workspaces, remove from state
-	if data.ConnectedTo.IsNull() || len(data.ConnectedTo.Elements()) == 0 {
+	// If no connected workspaces, remove from state. A tag selector that
+	// matches nothing legitimately has no connections.
+	if data.WorkspaceTags.IsNull() && (data.ConnectedTo.IsNull() || len(data.ConnectedTo.Elements()) == 0) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -319,13 +356,19 @@ func (r *WorkspaceInterconnectionResource) Update(ctx context.Context, req resou
 	}
 
 	// Connect new set
-	var newConnected []string
-	diags = plan.ConnectedTo.ElementsAs(ctx, &newConnected, false)
+	newConnected, diags := r.connectedTo(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if len(newConnected) == 0 {
+		plan.ConnectedTo = types.ListValueMust(types.StringType, []attr.Value{})
+		diags = resp.State.Set(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	connectReq := InterconnectionConnectRequest{
 		Workspaces: newConnected,
 		Condition:  plan.Condition.ValueString(),
@@ -433,6 +476,35 @@ func (r *WorkspaceInterconnectionResource) Delete(ctx context.Context, req resou
 	resp.State.RemoveResource(ctx)
 }
 
+// ModifyPlan resolves workspace_tags into connected_to so the plan shows every
+// workspace that will be triggered.
+func (r *WorkspaceInterconnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.provider == nil {
+		return
+	}
+
+	var plan WorkspaceInterconnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.WorkspaceTags.IsNull() {
+		return
+	}
+
+	var connectedTo types.List
+	if plan.WorkspaceName.IsUnknown() {
+		connectedTo = types.ListUnknown(types.StringType)
+	} else {
+		connectedTo, diags = planSelectedWorkspaces(ctx, r.provider, plan.OrganizationName, plan.WorkspaceTags, plan.WorkspaceName.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("connected_to"), connectedTo)
+	resp.Diagnostics.Append(diags...)
+}
+
 func (r *WorkspaceInterconnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.Split(req.ID, ":")
 	if len(parts) != 2 {
@@ -449,6 +521,7 @@ func (r *WorkspaceInterconnectionResource) ImportState(ctx context.Context, req
 	var data WorkspaceInterconnectionResourceModel
 	data.OrganizationName = types.StringValue(organizationName)
 	data.WorkspaceName = types.StringValue(workspaceName)
+	data.WorkspaceTags = types.MapNull(types.StringType)
 
 	readResp := &resource.ReadResponse{
 		State: resp.State,
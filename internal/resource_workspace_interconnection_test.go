@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -52,6 +53,18 @@ func (m *MockInterconnectionRoundTripper) RoundTrip(req *http.Request) (*http.Re
 		return resp, nil
 	}
 
+	// Handle List Workspaces (GET /api/organizations/{org}/workspaces/)
+	if req.Method == http.MethodGet && req.URL.Path == "/api/organizations/test-org/workspaces/" {
+		jsonResp := `[
+			{"id": "ws-id-0", "name": "ws-main", "tags": {"stage": "deploy"}},
+			{"id": "ws-id-1", "name": "ws-staging", "tags": {"stage": "deploy"}},
+			{"id": "ws-id-2", "name": "ws-production", "tags": {"stage": "deploy"}},
+			{"id": "ws-id-3", "name": "ws-sandbox", "tags": {"stage": "test"}}
+		]`
+		resp.Body = io.NopCloser(strings.NewReader(jsonResp))
+		return resp, nil
+	}
+
 	// Handle Disconnect (DELETE /api/organizations/{org}/workspaces/{ws}/connect_workspaces/)
 	if req.Method == http.MethodDelete && strings.Contains(url, "/connect_workspaces/") {
 		jsonResp := `{"disconnected": ["ws-staging", "ws-production"]}`
@@ -119,6 +132,7 @@ func TestWorkspaceInterconnectionResource_Create(t *testing.T) {
 		types.StringValue("ws-production"),
 	})
 	plan.Condition = types.StringValue("full_apply")
+	plan.WorkspaceTags = types.MapNull(types.StringType)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -163,6 +177,7 @@ func TestWorkspaceInterconnectionResource_Read(t *testing.T) {
 		types.StringValue("ws-staging"),
 	})
 	state.Condition = types.StringValue("full_apply")
+	state.WorkspaceTags = types.MapNull(types.StringType)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -205,6 +220,7 @@ func TestWorkspaceInterconnectionResource_Delete(t *testing.T) {
 		types.StringValue("ws-production"),
 	})
 	state.Condition = types.StringValue("full_apply")
+	state.WorkspaceTags = types.MapNull(types.StringType)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -262,3 +278,59 @@ func TestWorkspaceInterconnectionResource_ImportState_InvalidFormat(t *testing.T
 	require.True(t, response.Diagnostics.HasError())
 	assert.Contains(t, response.Diagnostics.Errors()[0].Summary(), "Invalid import ID format")
 }
+
+func TestWorkspaceInterconnectionResource_ModifyPlan_WorkspaceTags(t *testing.T) {
+	r := setupTestInterconnectionResource(t)
+	ctx := context.Background()
+
+	var plan WorkspaceInterconnectionResourceModel
+	plan.ID = types.StringUnknown()
+	plan.OrganizationName = types.StringValue("test-org")
+	plan.WorkspaceName = types.StringValue("ws-main")
+	plan.ConnectedTo = types.ListUnknown(types.StringType)
+	plan.Condition = types.StringValue("full_apply")
+	plan.WorkspaceTags = types.MapValueMust(types.StringType, map[string]attr.Value{"stage": types.StringValue("deploy")})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	tfPlan := tfsdk.Plan{Schema: schemaResp.Schema}
+	require.Empty(t, tfPlan.Set(ctx, &plan))
+
+	resp := &resource.ModifyPlanResponse{Plan: tfPlan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfPlan}, resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	// The source workspace matches the selector too but never triggers itself.
+	var connectedTo []string
+	require.Empty(t, resp.Plan.GetAttribute(ctx, path.Root("connected_to"), &connectedTo))
+	assert.Equal(t, []string{"ws-production", "ws-staging"}, connectedTo)
+}
+
+func TestWorkspaceInterconnectionResource_Create_WorkspaceTags(t *testing.T) {
+	r := setupTestInterconnectionResource(t)
+	ctx := context.Background()
+
+	var plan WorkspaceInterconnectionResourceModel
+	plan.ID = types.StringUnknown()
+	plan.OrganizationName = types.StringValue("test-org")
+	plan.WorkspaceName = types.StringValue("ws-main")
+	plan.ConnectedTo = types.ListUnknown(types.StringType)
+	plan.Condition = types.StringValue("full_apply")
+	plan.WorkspaceTags = types.MapValueMust(types.StringType, map[string]attr.Value{"stage": types.StringValue("deploy")})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	request := resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	require.Empty(t, request.Plan.Set(ctx, &plan))
+
+	response := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError())
+
+	var state WorkspaceInterconnectionResourceModel
+	require.Empty(t, response.State.Get(ctx, &state))
+	assert.Equal(t, "test-org:ws-main", state.ID.ValueString())
+	assert.Equal(t, 2, len(state.ConnectedTo.Elements()))
+}
@@ -7,17 +7,27 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &WorkspaceScheduleResource{}
+var _ resource.ResourceWithModifyPlan = &WorkspaceScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &WorkspaceScheduleResource{}
+
+// scheduleNextRunCount is the number of upcoming fire times exposed in next_run_times.
+const scheduleNextRunCount = 5
 
 func NewWorkspaceScheduleResource() resource.Resource {
 	return &WorkspaceScheduleResource{}
@@ -34,23 +44,42 @@ type WorkspaceScheduleResourceModel struct {
 	Type             types.String `tfsdk:"type"`
 	Crontab          types.String `tfsdk:"crontab"`
 	Schedule         types.String `tfsdk:"schedule"`
+	Timezone         types.String `tfsdk:"timezone"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	StartDate        types.String `tfsdk:"start_date"`
+	EndDate          types.String `tfsdk:"end_date"`
+	NextRunTimes     types.List   `tfsdk:"next_run_times"`
 }
 
 type WorkspaceScheduleAPIResponse struct {
-	ID       string `json:"id"`
-	Type     string `json:"type"`
-	Crontab  string `json:"crontab"`
-	Schedule string `json:"schedule"`
+	ID        string  `json:"id"`
+	Type      string  `json:"type"`
+	Crontab   string  `json:"crontab"`
+	Schedule  string  `json:"schedule"`
+	Timezone  string  `json:"timezone"`
+	Enabled   *bool   `json:"enabled"`
+	StartDate *string `json:"start_date"`
+	EndDate   *string `json:"end_date"`
 }
 
 type WorkspaceScheduleCreateRequest struct {
-	Type    string `json:"type"`
-	Crontab string `json:"crontab"`
+	Type      string  `json:"type"`
+	Crontab   string  `json:"crontab"`
+	Timezone  string  `json:"timezone,omitempty"`
+	Enabled   bool    `json:"enabled"`
+	StartDate *string `json:"start_date,omitempty"`
+	EndDate   *string `json:"end_date,omitempty"`
 }
 
+// WorkspaceScheduleUpdateRequest always carries start_date and end_date so
+// that removing a window from the configuration clears it on the API.
 type WorkspaceScheduleUpdateRequest struct {
-	Type    string `json:"type,omitempty"`
-	Crontab string `json:"crontab,omitempty"`
+	Type      string  `json:"type,omitempty"`
+	Crontab   string  `json:"crontab,omitempty"`
+	Timezone  string  `json:"timezone,omitempty"`
+	Enabled   *bool   `json:"enabled,omitempty"`
+	StartDate *string `json:"start_date"`
+	EndDate   *string `json:"end_date"`
 }
 
 // normalizeCrontab strips the human-readable annotation the API appends to a
@@ -63,6 +92,110 @@ func normalizeCrontab(s string) string {
 	return strings.TrimSpace(s)
 }
 
+// scheduleNextRunTimes returns the next fire times of the schedule after now
+// as an RFC 3339 string list in the schedule's timezone. Disabled schedules,
+// windows that have ended and expressions that fail to parse yield an empty
+// list; the schema validators report invalid input.
+func scheduleNextRunTimes(data WorkspaceScheduleResourceModel, now time.Time) types.List {
+	values := []string{}
+	parsed, err := parseCrontab(data.Crontab.ValueString())
+	loc, locErr := time.LoadLocation(data.Timezone.ValueString())
+	if err == nil && locErr == nil && data.Enabled.ValueBool() {
+		after := now.In(loc)
+		if start, err := time.Parse(time.RFC3339, data.StartDate.ValueString()); err == nil && start.After(after) {
+			// next() returns times strictly after its argument; step back so a
+			// run exactly at start_date is included.
+			after = start.In(loc).Add(-time.Second)
+		}
+		end, endErr := time.Parse(time.RFC3339, data.EndDate.ValueString())
+		for _, t := range parsed.nextN(after, scheduleNextRunCount) {
+			if endErr == nil && t.After(end) {
+				break
+			}
+			values = append(values, t.Format(time.RFC3339))
+		}
+	}
+	list, _ := types.ListValueFrom(context.Background(), types.StringType, values)
+	return list
+}
+
+// scheduleDateValue converts an optional API timestamp to state. A value that
+// denotes the same instant as the current one is kept as configured so a
+// different but equivalent API format does not show up as drift.
+func scheduleDateValue(current types.String, apiValue *string) types.String {
+	if apiValue == nil || *apiValue == "" {
+		return types.StringNull()
+	}
+	if !current.IsNull() && !current.IsUnknown() {
+		a, errA := time.Parse(time.RFC3339, current.ValueString())
+		b, errB := time.Parse(time.RFC3339, *apiValue)
+		if errA == nil && errB == nil && a.Equal(b) {
+			return current
+		}
+	}
+	return types.StringValue(*apiValue)
+}
+
+// scheduleDatePointer returns the configured timestamp for a request body, or
+// nil when unset.
+func scheduleDatePointer(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	s := v.ValueString()
+	return &s
+}
+
+// sameTimezone reports whether two IANA names load the same zone, e.g. an
+// alias such as "Etc/UTC" and the canonical "UTC". Zones are compared by their
+// name and offset in winter and summer so that DST rules have to agree too.
+func sameTimezone(a, b string) bool {
+	if a == b {
+		return true
+	}
+	locA, err := time.LoadLocation(a)
+	if err != nil {
+		return false
+	}
+	locB, err := time.LoadLocation(b)
+	if err != nil {
+		return false
+	}
+	year := time.Now().Year()
+	for _, month := range []time.Month{time.January, time.July} {
+		instant := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+		nameA, offsetA := instant.In(locA).Zone()
+		nameB, offsetB := instant.In(locB).Zone()
+		if nameA != nameB || offsetA != offsetB {
+			return false
+		}
+	}
+	return true
+}
+
+// applyScheduleResponse copies the timezone, enabled flag and window returned
+// by the API into the model, keeping defaults when the API omits them. A
+// configured timezone is kept when the API returns another name for the same
+// zone.
+func applyScheduleResponse(data *WorkspaceScheduleResourceModel, schedule WorkspaceScheduleAPIResponse) {
+	if schedule.Timezone != "" {
+		if data.Timezone.IsNull() || data.Timezone.IsUnknown() || !sameTimezone(data.Timezone.ValueString(), schedule.Timezone) {
+			data.Timezone = types.StringValue(schedule.Timezone)
+		}
+	} else if data.Timezone.IsNull() || data.Timezone.IsUnknown() {
+		data.Timezone = types.StringValue(schedule.Timezone)
+	} else if data.Timezone.IsNull() || data.Timezone.IsUnknown() {
+		data.Timezone = types.StringValue("UTC")
+	}
+	if schedule.Enabled != nil {
+		data.Enabled = types.BoolValue(*schedule.Enabled)
+	} else if data.Enabled.IsNull() || data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolValue(true)
+	}
+	data.StartDate = scheduleDateValue(data.StartDate, schedule.StartDate)
+	data.EndDate = scheduleDateValue(data.EndDate, schedule.EndDate)
+}
+
 func (r *WorkspaceScheduleResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "infradots_workspace_schedule"
 }
@@ -94,13 +227,50 @@ func (r *WorkspaceScheduleResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 			"crontab": schema.StringAttribute{
-				Description: "Cron expression in the format 'minute hour day_of_month month_of_year day_of_week' (e.g., '0 12 * * *').",
+				Description: "Cron expression in the format 'minute hour day_of_month month_of_year day_of_week' (e.g., '0 12 * * *'). Ranges, steps, lists, month and day names, and the @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly macros are supported.",
 				Required:    true,
+				Validators: []validator.String{
+					crontabValidator{},
+				},
 			},
 			"schedule": schema.StringAttribute{
 				Description: "Human-readable representation of the schedule returned by the API.",
 				Computed:    true,
 			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA time zone the crontab is evaluated in (e.g., 'Europe/Berlin'). Defaults to 'UTC'.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("UTC"),
+				Validators: []validator.String{
+					timezoneValidator{},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the schedule is active. Set to false to pause it without deleting it. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"start_date": schema.StringAttribute{
+				Description: "RFC 3339 timestamp before which the schedule does not fire.",
+				Optional:    true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"end_date": schema.StringAttribute{
+				Description: "RFC 3339 timestamp after which the schedule no longer fires.",
+				Optional:    true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"next_run_times": schema.ListAttribute{
+				Description: "The next times the schedule will fire, as RFC 3339 timestamps in the schedule's timezone. Empty when the schedule is disabled or its window has ended.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
@@ -113,6 +283,80 @@ func (r *WorkspaceScheduleResource) Configure(_ context.Context, req resource.Co
 	}
 }
 
+// ValidateConfig checks that the schedule window is not empty.
+func (r *WorkspaceScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WorkspaceScheduleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.StartDate.IsNull() || data.StartDate.IsUnknown() || data.EndDate.IsNull() || data.EndDate.IsUnknown() {
+		return
+	}
+	start, errStart := time.Parse(time.RFC3339, data.StartDate.ValueString())
+	end, errEnd := time.Parse(time.RFC3339, data.EndDate.ValueString())
+	if errStart != nil || errEnd != nil {
+		// Reported by the attribute validators.
+		return
+	}
+	if !end.After(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date"),
+			"Invalid schedule window",
+			fmt.Sprintf("end_date (%s) must be after start_date (%s).", data.EndDate.ValueString(), data.StartDate.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan fills in next_run_times when the schedule definition is set or
+// changed so the upcoming fire times are visible in the plan.
+func (r *WorkspaceScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan WorkspaceScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, v := range []attr.Value{plan.Crontab, plan.Timezone, plan.Enabled, plan.StartDate, plan.EndDate} {
+		if v.IsUnknown() {
+			return
+		}
+	}
+
+	nextRunTimes := scheduleNextRunTimes(plan, time.Now())
+	if !req.State.Raw.IsNull() {
+		var state WorkspaceScheduleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Keep the refreshed value while the definition is unchanged,
+		// otherwise every plan would show a diff as time moves on.
+		unchanged := state.Crontab.Equal(plan.Crontab) &&
+			state.Timezone.Equal(plan.Timezone) &&
+			state.Enabled.Equal(plan.Enabled) &&
+			state.StartDate.Equal(plan.StartDate) &&
+			state.EndDate.Equal(plan.EndDate)
+		if unchanged && !state.NextRunTimes.IsNull() {
+			nextRunTimes = state.NextRunTimes
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_run_times"), nextRunTimes)...)
+}
+
+// setPlannedNextRunTimes computes next_run_times during apply when the plan
+// could not know them (e.g. the crontab came from an unknown value).
+func setPlannedNextRunTimes(data *WorkspaceScheduleResourceModel) {
+	if data.NextRunTimes.IsUnknown() || data.NextRunTimes.IsNull() {
+		data.NextRunTimes = scheduleNextRunTimes(*data, time.Now())
+	}
+}
+
 func (r *WorkspaceScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data WorkspaceScheduleResourceModel
 	diags := req.Plan.Get(ctx, &data)
@@ -122,8 +366,12 @@ func (r *WorkspaceScheduleResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	createReq := WorkspaceScheduleCreateRequest{
-		Type:    data.Type.ValueString(),
-		Crontab: data.Crontab.ValueString(),
+		Type:      data.Type.ValueString(),
+		Crontab:   data.Crontab.ValueString(),
+		Timezone:  data.Timezone.ValueString(),
+		Enabled:   data.Enabled.ValueBool(),
+		StartDate: scheduleDatePointer(data.StartDate),
+		EndDate:   scheduleDatePointer(data.EndDate),
 	}
 
 	reqBody, err := json.Marshal(createReq)
@@ -180,6 +428,8 @@ func (r *WorkspaceScheduleResource) Create(ctx context.Context, req resource.Cre
 	// the Required crontab attribute with that value would violate Terraform's
 	// post-apply consistency check.
 	data.Schedule = types.StringValue(schedule.Schedule)
+	applyScheduleResponse(&data, schedule)
+	setPlannedNextRunTimes(&data)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -245,6 +495,8 @@ func (r *WorkspaceScheduleResource) Read(ctx context.Context, req resource.ReadR
 		data.Crontab = types.StringValue(normalizeCrontab(schedule.Crontab))
 	}
 	data.Schedule = types.StringValue(schedule.Schedule)
+	applyScheduleResponse(&data, schedule)
+	data.NextRunTimes = scheduleNextRunTimes(data, time.Now())
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -273,6 +525,15 @@ func (r *WorkspaceScheduleResource) Update(ctx context.Context, req resource.Upd
 	if !plan.Crontab.Equal(state.Crontab) {
 		updateReq.Crontab = plan.Crontab.ValueString()
 	}
+	if !plan.Timezone.Equal(state.Timezone) {
+		updateReq.Timezone = plan.Timezone.ValueString()
+	}
+	if !plan.Enabled.Equal(state.Enabled) {
+		v := plan.Enabled.ValueBool()
+		updateReq.Enabled = &v
+	}
+	updateReq.StartDate = scheduleDatePointer(plan.StartDate)
+	updateReq.EndDate = scheduleDatePointer(plan.EndDate)
 
 	reqBody, err := json.Marshal(updateReq)
 	if err != nil {
@@ -327,6 +588,8 @@ func (r *WorkspaceScheduleResource) Update(ctx context.Context, req resource.Upd
 	// Keep the configured crontab; the API annotates the returned value, which
 	// would otherwise break post-apply consistency for this Required attribute.
 	plan.Schedule = types.StringValue(schedule.Schedule)
+	applyScheduleResponse(&plan, schedule)
+	setPlannedNextRunTimes(&plan)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -441,6 +704,8 @@ func (r *WorkspaceScheduleResource) ImportState(ctx context.Context, req resourc
 	data.Type = types.StringValue(schedule.Type)
 	data.Crontab = types.StringValue(normalizeCrontab(schedule.Crontab))
 	data.Schedule = types.StringValue(schedule.Schedule)
+	applyScheduleResponse(&data, schedule)
+	data.NextRunTimes = scheduleNextRunTimes(data, time.Now())
 
 	diags := resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
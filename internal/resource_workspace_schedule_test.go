@@ -1,6 +1,12 @@
 package internal
 
-import "testing"
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 func TestNormalizeCrontab(t *testing.T) {
 	cases := map[string]string{
@@ -19,3 +25,102 @@ func TestNormalizeCrontab(t *testing.T) {
 		}
 	}
 }
+
+func scheduleModel(crontab string) WorkspaceScheduleResourceModel {
+	return WorkspaceScheduleResourceModel{
+		Crontab:   types.StringValue(crontab),
+		Timezone:  types.StringValue("UTC"),
+		Enabled:   types.BoolValue(true),
+		StartDate: types.StringNull(),
+		EndDate:   types.StringNull(),
+	}
+}
+
+func nextRunTimeStrings(t *testing.T, data WorkspaceScheduleResourceModel, now time.Time) []string {
+	t.Helper()
+	var got []string
+	diags := scheduleNextRunTimes(data, now).ElementsAs(context.Background(), &got, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return got
+}
+
+func TestScheduleNextRunTimes(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	got := nextRunTimeStrings(t, scheduleModel("0 12 * * *"), now)
+	if len(got) != scheduleNextRunCount {
+		t.Fatalf("got %d run times, want %d", len(got), scheduleNextRunCount)
+	}
+	if got[0] != "2025-01-01T12:00:00Z" || got[1] != "2025-01-02T12:00:00Z" {
+		t.Errorf("unexpected run times: %v", got)
+	}
+
+	// Invalid expressions produce an empty, known list.
+	if got := nextRunTimeStrings(t, scheduleModel("not a cron"), now); len(got) != 0 {
+		t.Errorf("expected no run times for invalid crontab, got %v", got)
+	}
+}
+
+func TestScheduleNextRunTimes_Timezone(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+	data := scheduleModel("0 9 * * *")
+	data.Timezone = types.StringValue("America/New_York")
+
+	got := nextRunTimeStrings(t, data, now)
+	// 10:30 UTC is 05:30 in New York, so the first run is the same day.
+	if got[0] != "2025-01-01T09:00:00-05:00" {
+		t.Errorf("unexpected first run time: %v", got)
+	}
+}
+
+func TestScheduleNextRunTimes_DisabledAndWindow(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	disabled := scheduleModel("0 12 * * *")
+	disabled.Enabled = types.BoolValue(false)
+	if got := nextRunTimeStrings(t, disabled, now); len(got) != 0 {
+		t.Errorf("expected no run times for disabled schedule, got %v", got)
+	}
+
+	windowed := scheduleModel("0 12 * * *")
+	windowed.StartDate = types.StringValue("2025-02-01T12:00:00Z")
+	windowed.EndDate = types.StringValue("2025-02-03T00:00:00Z")
+	got := nextRunTimeStrings(t, windowed, now)
+	want := []string{"2025-02-01T12:00:00Z", "2025-02-02T12:00:00Z"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestScheduleDateValue(t *testing.T) {
+	current := types.StringValue("2025-01-01T00:00:00Z")
+	equivalent := "2025-01-01T01:00:00+01:00"
+	if got := scheduleDateValue(current, &equivalent); !got.Equal(current) {
+		t.Errorf("equivalent timestamp should keep configured value, got %v", got)
+	}
+
+	other := "2025-06-01T00:00:00Z"
+	if got := scheduleDateValue(current, &other); got.ValueString() != other {
+		t.Errorf("changed timestamp should be taken from the API, got %v", got)
+	}
+
+	if got := scheduleDateValue(current, nil); !got.IsNull() {
+		t.Errorf("missing timestamp should be null, got %v", got)
+	}
+}
+
+func TestApplyScheduleResponse_Timezone(t *testing.T) {
+	data := scheduleModel("0 9 * * 1")
+	data.Timezone = types.StringValue("Etc/UTC")
+	applyScheduleResponse(&data, WorkspaceScheduleAPIResponse{Timezone: "UTC"})
+	if got := data.Timezone.ValueString(); got != "Etc/UTC" {
+		t.Errorf("equivalent timezone should keep configured value, got %q", got)
+	}
+
+	applyScheduleResponse(&data, WorkspaceScheduleAPIResponse{Timezone: "Europe/Paris"})
+	if got := data.Timezone.ValueString(); got != "Europe/Paris" {
+		t.Errorf("changed timezone should be taken from the API, got %q", got)
+	}
+}
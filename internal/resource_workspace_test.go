@@ -18,7 +18,9 @@ import (
 )
 
 // MockWorkspaceRoundTripper implements http.RoundTripper for testing workspace resource
-type MockWorkspaceRoundTripper struct{}
+type MockWorkspaceRoundTripper struct {
+	lastPatch string
+}
 
 func (m *MockWorkspaceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	// Create a mocked response based on the request
@@ -105,6 +107,8 @@ func (m *MockWorkspaceRoundTripper) RoundTrip(req *http.Request) (*http.Response
 
 	// Handle Update (PATCH to /api/organizations/{org_name}/workspaces/{workspace_name})
 	if req.Method == http.MethodPatch && strings.Contains(url, "/api/organizations/test-org/workspaces/test-workspace") {
+		body, _ := io.ReadAll(req.Body)
+		m.lastPatch = string(body)
 		jsonResp := `{
 			"id": "3f340e3c-89f1-4321-bcde-eff34567890a",
 			"name": "updated-workspace",
@@ -145,6 +149,21 @@ func (m *MockWorkspaceRoundTripper) RoundTrip(req *http.Request) (*http.Response
 		return resp, nil
 	}
 
+	// Handle organization lookup used to resolve effective settings
+	if req.Method == http.MethodGet && strings.HasSuffix(url, "/api/organizations/test-org/") {
+		jsonResp := `{
+			"id": "org-12345",
+			"name": "test-org",
+			"execution_mode": "remote",
+			"agents_enabled": true,
+			"drift_detection_enabled": true,
+			"remedy_drift": false,
+			"auto_implement_changes": false
+		}`
+		resp.Body = io.NopCloser(strings.NewReader(jsonResp))
+		return resp, nil
+	}
+
 	// Handle Import - List workspaces (GET to /api/organizations/{org_name}/workspaces/)
 	// This must come after the Read handler to avoid matching single workspace requests
 	// Only match if it's exactly the list endpoint (ends with /workspaces/)
@@ -244,6 +263,7 @@ func TestWorkspaceResource_Create(t *testing.T) {
 		"created_at":  types.StringType,
 		"updated_at":  types.StringType,
 	})
+	plan.EffectiveSettings = types.ObjectNull(effectiveSettingsAttrTypes)
 
 	// Create request/response objects
 	schemaResp := &resource.SchemaResponse{}
@@ -321,6 +341,7 @@ func TestWorkspaceResource_Read(t *testing.T) {
 		"created_at":  types.StringType,
 		"updated_at":  types.StringType,
 	})
+	state.EffectiveSettings = types.ObjectNull(effectiveSettingsAttrTypes)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -361,6 +382,63 @@ func TestWorkspaceResource_Read(t *testing.T) {
 	assert.Equal(t, "https://github.com/test/repo", newState.Source.ValueString())
 	assert.Equal(t, "main", newState.Branch.ValueString())
 	assert.Equal(t, "1.5.0", newState.TerraformVersion.ValueString())
+
+	// Drift flags are not set on the workspace, so they resolve from the organization.
+	settings := newState.EffectiveSettings.Attributes()
+	assert.Equal(t, types.BoolValue(true), settings["drift_detection_enabled"])
+	assert.Equal(t, types.StringValue("organization"), settings["drift_detection_enabled_source"])
+	assert.Equal(t, types.StringValue("Remote"), settings["execution_mode"])
+	assert.Equal(t, types.StringValue("workspace"), settings["execution_mode_source"])
+}
+
+func TestResolveEffectiveSettings(t *testing.T) {
+	override := true
+	workspace := WorkspaceAPIResponse{
+		ExecutionMode: "",
+		AgentsEnabled: true,
+		RemedyDrift:   &override,
+	}
+	defaultModelProvider := "mp-hosted"
+	org := OrganizationAPIResponse{
+		ExecutionMode:          "local",
+		AgentsEnabled:          false,
+		DriftDetectionEnabled:  true,
+		RemedyDrift:            false,
+		DefaultModelProviderID: &defaultModelProvider,
+	}
+
+	settings := resolveEffectiveSettings(workspace, org).Attributes()
+
+	assert.Equal(t, types.StringValue("local"), settings["execution_mode"])
+	assert.Equal(t, types.StringValue("organization"), settings["execution_mode_source"])
+	// The organization disabling agents wins over the workspace flag.
+	assert.Equal(t, types.BoolValue(false), settings["agents_enabled"])
+	assert.Equal(t, types.StringValue("organization"), settings["agents_enabled_source"])
+	assert.Equal(t, types.BoolValue(true), settings["drift_detection_enabled"])
+	assert.Equal(t, types.StringValue("organization"), settings["drift_detection_enabled_source"])
+	assert.Equal(t, types.BoolValue(true), settings["remedy_drift"])
+	assert.Equal(t, types.StringValue("workspace"), settings["remedy_drift_source"])
+	assert.Equal(t, types.StringValue("organization"), settings["auto_implement_changes_source"])
+	assert.Equal(t, types.StringValue("mp-hosted"), settings["model_provider_id"])
+	assert.Equal(t, types.StringValue("organization"), settings["model_provider_id_source"])
+}
+
+func TestResolveEffectiveSettings_ModelProvider(t *testing.T) {
+	selfHosted := "mp-self-hosted"
+	defaultModelProvider := "mp-hosted"
+
+	// A workspace override wins over the organization default.
+	settings := resolveEffectiveSettings(
+		WorkspaceAPIResponse{ModelProviderID: &selfHosted},
+		OrganizationAPIResponse{DefaultModelProviderID: &defaultModelProvider},
+	).Attributes()
+	assert.Equal(t, types.StringValue("mp-self-hosted"), settings["model_provider_id"])
+	assert.Equal(t, types.StringValue("workspace"), settings["model_provider_id_source"])
+
+	// Neither level selects a model provider.
+	settings = resolveEffectiveSettings(WorkspaceAPIResponse{}, OrganizationAPIResponse{}).Attributes()
+	assert.Equal(t, types.StringNull(), settings["model_provider_id"])
+	assert.Equal(t, types.StringValue("organization"), settings["model_provider_id_source"])
 }
 
 func TestWorkspaceResource_Update(t *testing.T) {
@@ -397,7 +475,11 @@ func TestWorkspaceResource_Update(t *testing.T) {
 		"created_at":  types.StringType,
 		"updated_at":  types.StringType,
 	})
+	state.EffectiveSettings = types.ObjectNull(effectiveSettingsAttrTypes)
+	state.ModelProviderID = types.StringValue("mp-self-hosted")
 
+	// model_provider_id is removed, so the workspace inherits the
+	// organization's default model provider again.
 	var plan WorkspaceResourceModel
 	plan.ID = types.StringValue("3f340e3c-89f1-4321-bcde-eff34567890a")
 	plan.OrganizationName = types.StringValue("test-org")
@@ -425,6 +507,7 @@ func TestWorkspaceResource_Update(t *testing.T) {
 		"created_at":  types.StringType,
 		"updated_at":  types.StringType,
 	})
+	plan.EffectiveSettings = types.ObjectNull(effectiveSettingsAttrTypes)
 
 	// Create request/response objects
 	schemaResp := &resource.SchemaResponse{}
@@ -476,6 +559,9 @@ func TestWorkspaceResource_Update(t *testing.T) {
 	assert.Equal(t, "develop", newState.Branch.ValueString())
 	assert.Equal(t, "1.6.0", newState.TerraformVersion.ValueString())
 	assert.Equal(t, "2025-07-07T12:01:00Z", newState.UpdatedAt.ValueString())
+
+	mock := r.provider.client.Transport.(*MockWorkspaceRoundTripper)
+	assert.Contains(t, mock.lastPatch, `"model_provider_id":null`)
 }
 
 func TestWorkspaceResource_Delete(t *testing.T) {
@@ -508,6 +594,7 @@ func TestWorkspaceResource_Delete(t *testing.T) {
 		"created_at":  types.StringType,
 		"updated_at":  types.StringType,
 	})
+	state.EffectiveSettings = types.ObjectNull(effectiveSettingsAttrTypes)
 
 	schemaResp := &resource.SchemaResponse{}
 	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
@@ -606,6 +693,11 @@ func TestWorkspaceResource_Schema(t *testing.T) {
 	assert.Contains(t, vcsAttr.Attributes, "description")
 	assert.Contains(t, vcsAttr.Attributes, "created_at")
 	assert.Contains(t, vcsAttr.Attributes, "updated_at")
+
+	// effective_settings attributes must line up with the object type used in state
+	effectiveAttr := attrs["effective_settings"].(schema.SingleNestedAttribute)
+	assert.True(t, effectiveAttr.Computed)
+	assert.Equal(t, effectiveSettingsAttrTypes, effectiveAttr.GetType().(types.ObjectType).AttrTypes)
 }
 
 func TestWorkspaceResource_Metadata(t *testing.T) {
@@ -799,6 +891,7 @@ func TestWorkspaceResource_Create_SendsTriggerPatterns(t *testing.T) {
 	plan.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{})
 	plan.TflintPlugins = types.ListNull(types.StringType)
 	plan.VCS = nullVCSObject()
+	plan.EffectiveSettings = types.ObjectNull(effectiveSettingsAttrTypes)
 	plan.TriggerPatterns = types.ListValueMust(triggerPatternObjectType, []attr.Value{
 		types.ObjectValueMust(triggerPatternAttrTypes, map[string]attr.Value{
 			"pattern": types.StringValue("modules/vpc/.*"),
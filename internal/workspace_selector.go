@@ -0,0 +1,199 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// workspaceTagsMatch reports whether every key/value pair of the selector is
+// present in the workspace tags. Tag values are compared in their string form
+// because the API does not guarantee they are strings.
+func workspaceTagsMatch(tags map[string]any, selector map[string]string) bool {
+	for k, want := range selector {
+		got, ok := tags[k]
+		if !ok || got == nil || fmt.Sprint(got) != want {
+			return false
+		}
+	}
+	return true
+}
+
+// workspaceSelectorFromMap converts a workspace_tags attribute value into a
+// selector. Null and unknown elements are skipped.
+func workspaceSelectorFromMap(m types.Map) map[string]string {
+	selector := map[string]string{}
+	for k, v := range m.Elements() {
+		if s, ok := v.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			selector[k] = s.ValueString()
+		}
+	}
+	return selector
+}
+
+// workspaceSelectorKnown reports whether a workspace_tags value and all of
+// its elements are known. A selector with an unknown value cannot be matched
+// yet: skipping the element would select too many workspaces.
+func workspaceSelectorKnown(m types.Map) bool {
+	if m.IsUnknown() {
+		return false
+	}
+	for _, v := range m.Elements() {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// workspaceSelectorString renders a selector deterministically, e.g.
+// "env=prod,team=platform". It is used to build resource IDs.
+func workspaceSelectorString(selector map[string]string) string {
+	pairs := make([]string, 0, len(selector))
+	for k, v := range selector {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// listWorkspacesByTags returns the sorted names of the workspaces in the
+// organization whose tags match the selector.
+func listWorkspacesByTags(p *InfradotsProvider, organizationName string, selector map[string]string) ([]string, error) {
+	url := fmt.Sprintf("https://%s/api/organizations/%s/workspaces/", p.host, organizationName)
+
+	reqHttp, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	reqHttp.Header.Set("Authorization", "Bearer "+p.token)
+
+	httpResp, err := p.client.Do(reqHttp)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != 200 {
+		return nil, fmt.Errorf("status code: %d, body: %s", httpResp.StatusCode, string(respBody))
+	}
+
+	var workspaces []WorkspaceAPIResponse
+	if err := json.Unmarshal(respBody, &workspaces); err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, ws := range workspaces {
+		if workspaceTagsMatch(ws.Tags, selector) {
+			names = append(names, ws.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// planSelectedWorkspaces expands a workspace_tags selector into the list of
+// matching workspace names so the plan shows which workspaces are affected.
+// The result is unknown while the organization or any part of the selector
+// is unknown.
+// exclude is removed from the matches, e.g. the source of an interconnection.
+func planSelectedWorkspaces(ctx context.Context, p *InfradotsProvider, organizationName types.String, selector types.Map, exclude string) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if organizationName.IsUnknown() || !workspaceSelectorKnown(selector) {
+		return types.ListUnknown(types.StringType), diags
+	}
+
+	tags := workspaceSelectorFromMap(selector)
+	names, err := listWorkspacesByTags(p, organizationName.ValueString(), tags)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("workspace_tags"),
+			"Unable to resolve workspace_tags",
+			fmt.Sprintf("Listing workspaces of organization '%s' failed: %s", organizationName.ValueString(), err),
+		)
+		return types.ListUnknown(types.StringType), diags
+	}
+	if exclude != "" {
+		names = removeFromSet(names, exclude)
+		if names == nil {
+			names = []string{}
+		}
+	}
+
+	if len(names) == 0 {
+		diags.AddAttributeWarning(
+			path.Root("workspace_tags"),
+			"No workspaces match workspace_tags",
+			fmt.Sprintf("No workspace in organization '%s' has the tags %s. The resource will not affect any workspace until one does.",
+				organizationName.ValueString(), workspaceSelectorString(tags)),
+		)
+	}
+
+	list, d := types.ListValueFrom(ctx, types.StringType, names)
+	diags.Append(d...)
+	return list, diags
+}
+
+// planWorkspaceSelection computes the planned selected_workspaces of a
+// resource that targets either a single workspace_name or every workspace
+// matching workspace_tags. It is null when neither is set.
+func planWorkspaceSelection(ctx context.Context, p *InfradotsProvider, organizationName, workspaceName types.String, selector types.Map) (types.List, diag.Diagnostics) {
+	switch {
+	case !selector.IsNull():
+		return planSelectedWorkspaces(ctx, p, organizationName, selector, "")
+	case workspaceName.IsUnknown():
+		return types.ListUnknown(types.StringType), nil
+	case !workspaceName.IsNull() && workspaceName.ValueString() != "":
+		return types.ListValueFrom(ctx, types.StringType, []string{workspaceName.ValueString()})
+	default:
+		return types.ListNull(types.StringType), nil
+	}
+}
+
+// selectedWorkspaceNames returns the workspaces a tag-selected resource
+// applies to. The planned selection is used when known; otherwise (e.g. the
+// organization was unknown during plan) the selector is expanded now.
+func selectedWorkspaceNames(ctx context.Context, p *InfradotsProvider, organizationName types.String, selector types.Map, selected types.List, exclude string) ([]string, types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if selected.IsNull() || selected.IsUnknown() {
+		selected, diags = planSelectedWorkspaces(ctx, p, organizationName, selector, exclude)
+		if diags.HasError() {
+			return nil, selected, diags
+		}
+	}
+
+	names := []string{}
+	diags.Append(selected.ElementsAs(ctx, &names, false)...)
+	return names, selected, diags
+}
+
+// stringSetDifference returns the values of a that are not in b, preserving
+// the order of a.
+func stringSetDifference(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, v := range b {
+		in[v] = true
+	}
+	var result []string
+	for _, v := range a {
+		if !in[v] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,30 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkspaceTagsMatch(t *testing.T) {
+	tags := map[string]any{"env": "prod", "tier": "network", "critical": true}
+
+	assert.True(t, workspaceTagsMatch(tags, map[string]string{"env": "prod"}))
+	assert.True(t, workspaceTagsMatch(tags, map[string]string{"env": "prod", "tier": "network"}))
+	assert.True(t, workspaceTagsMatch(tags, map[string]string{"critical": "true"}))
+	assert.True(t, workspaceTagsMatch(tags, map[string]string{}))
+
+	assert.False(t, workspaceTagsMatch(tags, map[string]string{"env": "dev"}))
+	assert.False(t, workspaceTagsMatch(tags, map[string]string{"env": "prod", "owner": "platform"}))
+	assert.False(t, workspaceTagsMatch(nil, map[string]string{"env": "prod"}))
+}
+
+func TestWorkspaceSelectorString(t *testing.T) {
+	assert.Equal(t, "env=prod,tier=network", workspaceSelectorString(map[string]string{"tier": "network", "env": "prod"}))
+	assert.Equal(t, "", workspaceSelectorString(nil))
+}
+
+func TestStringSetDifference(t *testing.T) {
+	assert.Equal(t, []string{"a", "c"}, stringSetDifference([]string{"a", "b", "c"}, []string{"b", "d"}))
+	assert.Nil(t, stringSetDifference([]string{"a"}, []string{"a"}))
+}